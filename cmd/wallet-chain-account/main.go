@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/server"
+	"github.com/web3-fighter/wallet-chain-account/service"
+	"github.com/web3-fighter/wallet-chain-account/service/auth"
+	"github.com/web3-fighter/wallet-chain-account/service/dispatcher"
+)
+
+func main() {
+	var configPath string
+	flag.StringVar(&configPath, "c", "./etc/config.yml", "config file path")
+	flag.Parse()
+
+	conf, err := config.New(configPath)
+	if err != nil {
+		log.Error("load config fail", "err", err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	chainDispatcher, err := dispatcher.NewChainDispatcher(ctx, conf)
+	if err != nil {
+		log.Error("new chain dispatcher fail", "err", err)
+		os.Exit(1)
+	}
+
+	var accountService service.WalletAccountService = chainDispatcher
+	if conf.DisableAuth {
+		log.Warn("disable_auth is set, consumer token check disabled")
+	} else {
+		if len(conf.Consumers) == 0 {
+			log.Warn("no consumers configured, all requests will be rejected")
+		}
+		accountService = auth.NewAuthService(conf.Consumers, chainDispatcher)
+	}
+
+	grpcServer := server.NewGrpcServer(conf.Server, accountService)
+	if err := grpcServer.Start(ctx); err != nil {
+		log.Error("start grpc server fail", "err", err)
+		os.Exit(1)
+	}
+
+	var httpServer *server.HttpServer
+	if conf.Server.HttpPort != "" {
+		httpServer = server.NewHttpServer(conf.Server, accountService)
+		if err := httpServer.Start(ctx); err != nil {
+			log.Error("start http server fail", "err", err)
+			os.Exit(1)
+		}
+	}
+
+	<-ctx.Done()
+	log.Info("shutting down")
+	if httpServer != nil {
+		_ = httpServer.Stop(context.Background())
+	}
+	_ = grpcServer.Stop(context.Background())
+}
@@ -9,7 +9,8 @@ import (
 )
 
 type Server struct {
-	Port string `yaml:"port"`
+	Port     string `yaml:"port"`
+	HttpPort string `yaml:"http_port"`
 }
 
 type Node struct {
@@ -20,6 +21,39 @@ type Node struct {
 	DataApiKey   string `yaml:"data_api_key"`
 	DataApiToken string `yaml:"data_api_token"`
 	TimeOut      uint64 `yaml:"time_out"`
+	// RpcUrls 可选，额外的节点地址，与 RpcUrl 一起组成多节点故障转移
+	RpcUrls []string `yaml:"rpc_urls"`
+	// BroadcastAll 多节点时 SendRawTransaction 广播到所有健康节点
+	BroadcastAll bool `yaml:"broadcast_all"`
+	// DebugTrace 节点开放 debug_traceTransaction / debug_traceBlockByNumber（callTracer）时开启，用于解析合约内部的原生币转账
+	DebugTrace bool `yaml:"debug_trace"`
+	// GasLimitMargin EVM 链 GetFee 估算 gas 上限时额外增加的百分比，0 使用默认值 20
+	GasLimitMargin uint64 `yaml:"gas_limit_margin"`
+	// FeePercentiles EVM 链 GetFee 慢 / 中 / 快三档取的小费（legacy 链为 gasPrice）分位数，为空使用默认值 [10, 50, 90]
+	FeePercentiles []float64 `yaml:"fee_percentiles"`
+	// BaseFeeHeadroom maxFeePerGas 在下一区块 base fee 基础上预留的上涨空间（百分比），0 使用默认值 100
+	BaseFeeHeadroom uint64 `yaml:"base_fee_headroom"`
+	// FeeHistoryBlocks eth_feeHistory 统计的区块数，0 使用默认值 20
+	FeeHistoryBlocks uint64 `yaml:"fee_history_blocks"`
+	// MaxHeaderRange EVM 链 ListBlockHeaderByRange 单次最多返回的区块头数，0 使用默认值 1000
+	MaxHeaderRange uint64 `yaml:"max_header_range"`
+}
+
+// Endpoints 返回去重后的全部节点地址，RpcUrl 排在最前
+func (n Node) Endpoints() []string {
+	seen := make(map[string]struct{}, len(n.RpcUrls)+1)
+	endpoints := make([]string, 0, len(n.RpcUrls)+1)
+	for _, url := range append([]string{n.RpcUrl}, n.RpcUrls...) {
+		if url == "" {
+			continue
+		}
+		if _, ok := seen[url]; ok {
+			continue
+		}
+		seen[url] = struct{}{}
+		endpoints = append(endpoints, url)
+	}
+	return endpoints
 }
 
 type WalletNode struct {
@@ -44,11 +78,42 @@ type WalletNode struct {
 	Icp     Node `yaml:"icp"`
 }
 
+// Consumer 调用方配置：token 绑定允许访问的链以及允许调用的方法类别
+// Chains 为 "*" 表示全部链；Access 取值 read / sign / broadcast
+type Consumer struct {
+	Name   string   `yaml:"name"`
+	Token  string   `yaml:"token"`
+	Chains []string `yaml:"chains"`
+	Access []string `yaml:"access"`
+}
+
+// ChainProfile EVM 链的协议特性，按 chain_id 覆盖或追加内置的 evmbase.ChainProfile；
+// 只覆盖配置了的字段，未配置的字段沿用内置 profile（未登记的链沿用默认 profile）
+type ChainProfile struct {
+	ChainId      uint64 `yaml:"chain_id"`
+	Batch        *bool  `yaml:"batch"`
+	MaxBatchSize int    `yaml:"max_batch_size"`
+	EIP1559      *bool  `yaml:"eip1559"`
+	EIP4844      *bool  `yaml:"eip4844"`
+	EIP7702      *bool  `yaml:"eip7702"`
+	SafeTag      *bool  `yaml:"safe_tag"`
+	FinalizedTag *bool  `yaml:"finalized_tag"`
+	// Multicall3 可选，Multicall3 不在统一地址 0xcA11bde05977b3631167028862bE2a173976CA11 时配置
+	Multicall3 string `yaml:"multicall3"`
+	// EnsRegistry 可选，部署了 ENS Registry 的链（如私链、本地测试链）配置后支持 ENS 解析
+	EnsRegistry string `yaml:"ens_registry"`
+}
+
 type Config struct {
 	Server     Server     `yaml:"server"`
 	WalletNode WalletNode `yaml:"wallet_node"`
 	NetWork    string     `yaml:"network"`
 	Chains     []string   `yaml:"chains"`
+	Consumers  []Consumer `yaml:"consumers"`
+	// DisableAuth 为 true 时不校验 ConsumerToken，仅用于本地调试；默认校验，未配置 consumers 时拒绝全部请求
+	DisableAuth bool `yaml:"disable_auth"`
+	// ChainProfiles 可选，内置 profile 以外的 EVM 链特性
+	ChainProfiles []ChainProfile `yaml:"chain_profiles"`
 }
 
 func New(path string) (*Config, error) {
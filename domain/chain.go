@@ -21,7 +21,7 @@ type VerifyTransactionParam struct {
 	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
 	PublicKey     string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	Signature     string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
-	RawTx         string `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	RawTx         string `protobuf:"bytes,6,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
 }
 
 type DecodeTransactionParam struct {
@@ -29,6 +29,8 @@ type DecodeTransactionParam struct {
 	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
 	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
 	RawTx         string `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	// Abi 可选，EVM 链按此 ABI 片段解码交易中的合约调用
+	Abi string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
 }
 
 type SignedTransactionParam struct {
@@ -52,6 +54,57 @@ type UnSignTransactionParam struct {
 	Base64Tx      string `protobuf:"bytes,4,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
 }
 
+// UnSignTransaction UnSignTx 为待签名哈希。请求中的地址以 ENS 名称给出时，ResolvedAddresses 列出每个名称解析出的地址供签名方核对，
+// Base64Tx 为名称替换成十六进制地址后的交易请求，BuildSignedTransaction 须使用该请求，保证签名的交易与待签名哈希一致
+type UnSignTransaction struct {
+	UnSignTx          string            `protobuf:"bytes,1,opt,name=un_sign_tx,json=unSignTx,proto3" json:"un_sign_tx,omitempty"`
+	Base64Tx          string            `protobuf:"bytes,2,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	ResolvedAddresses []ResolvedAddress `protobuf:"bytes,3,rep,name=resolved_addresses,json=resolvedAddresses,proto3" json:"resolved_addresses,omitempty"`
+}
+
+// ResolvedAddress Field 为请求中的字段名（如 from_address / to_address / contract_address），Name 为规范化后的 ENS 名称
+type ResolvedAddress struct {
+	Field   string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+// ReplacementTransactionParam 为交易池中的 TxHash 构造同 nonce 的替换交易，Mode 取 ReplacementMode*；
+// MaxFeePerGas / MaxPriorityFeePerGas（EIP-1559）或 GasPrice（legacy）为空时按最低涨幅与当前快速档自动取值
+type ReplacementTransactionParam struct {
+	ConsumerToken        string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain                string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network              string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	TxHash               string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Mode                 string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	MaxFeePerGas         string `protobuf:"bytes,6,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `protobuf:"bytes,7,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	GasPrice             string `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+}
+
+// ReplacementTransactionParam.Mode 的取值
+const (
+	// ReplacementModeSpeedUp 保持交易内容不变，提高费用
+	ReplacementModeSpeedUp = "speed_up"
+	// ReplacementModeCancel 替换为向自己转 0 的原生币转账
+	ReplacementModeCancel = "cancel"
+)
+
+// ReplacementTransaction Base64Tx 为替换交易的请求 JSON，签名后原样传给 BuildSignedTransaction；UnSignTx 为待签名哈希
+type ReplacementTransaction struct {
+	Base64Tx string `protobuf:"bytes,1,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	UnSignTx string `protobuf:"bytes,2,opt,name=un_sign_tx,json=unSignTx,proto3" json:"un_sign_tx,omitempty"`
+	Nonce    uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce"`
+}
+
+// UnSignAuthorizationParam Base64Authorization 为 base64 编码的授权 JSON（如 EIP-7702 的 chain_id / address / nonce）
+type UnSignAuthorizationParam struct {
+	ConsumerToken       string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain               string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network             string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Base64Authorization string `protobuf:"bytes,4,opt,name=base64_authorization,json=base64Authorization,proto3" json:"base64_authorization,omitempty"`
+}
+
 type GetTxByHashParam struct {
 	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
 	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -85,6 +138,18 @@ type TxMessage struct {
 	ContractAddress string   `protobuf:"bytes,10,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	Datetime        string   `protobuf:"bytes,11,opt,name=datetime,proto3" json:"datetime,omitempty"`
 	Data            string   `protobuf:"bytes,12,opt,name=data,proto3" json:"data,omitempty"`
+	// TokenAddresses / TokenIds 与 Froms、Tos、Values 按下标一一对应；原生币转账的代币地址为零地址，同质化代币的 TokenId 为空
+	TokenAddresses []string `protobuf:"bytes,13,rep,name=token_addresses,json=tokenAddresses,proto3" json:"token_addresses,omitempty"`
+	TokenIds       []string `protobuf:"bytes,14,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// Blob* 仅 EIP-4844 blob 交易，BlobFee = BlobGasUsed * BlobGasPrice，已计入 Fee
+	BlobVersionedHashes []string `protobuf:"bytes,15,rep,name=blob_versioned_hashes,json=blobVersionedHashes,proto3" json:"blob_versioned_hashes,omitempty"`
+	MaxFeePerBlobGas    string   `protobuf:"bytes,16,opt,name=max_fee_per_blob_gas,json=maxFeePerBlobGas,proto3" json:"max_fee_per_blob_gas,omitempty"`
+	BlobGasUsed         uint64   `protobuf:"varint,17,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	BlobGasPrice        string   `protobuf:"bytes,18,opt,name=blob_gas_price,json=blobGasPrice,proto3" json:"blob_gas_price,omitempty"`
+	BlobFee             string   `protobuf:"bytes,19,opt,name=blob_fee,json=blobFee,proto3" json:"blob_fee,omitempty"`
+	// Decimals / Symbols 与 Values 按下标对应，取不到代币信息时 Symbol 为空
+	Decimals []uint32 `protobuf:"varint,20,rep,packed,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbols  []string `protobuf:"bytes,21,rep,name=symbols,proto3" json:"symbols,omitempty"`
 }
 
 type SendTxParam struct {
@@ -93,6 +158,42 @@ type SendTxParam struct {
 	Coin          string `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
 	Network       string `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
 	RawTx         string `protobuf:"bytes,5,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	// Simulate 广播前先预执行，revert 时拒绝广播；Abi 可选，用于解码自定义错误
+	Simulate bool   `protobuf:"varint,6,opt,name=simulate,proto3" json:"simulate,omitempty"`
+	Abi      string `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+// SimulateTransactionParam RawTx 为已签名交易，Abi 可选，用于解码自定义错误
+type SimulateTransactionParam struct {
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	RawTx         string `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Abi           string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+}
+
+// SimulationResult 交易预执行结果，Success 为 false 时 Revert 为解码后的 revert 原因
+type SimulationResult struct {
+	Success    bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	ReturnData string        `protobuf:"bytes,2,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+	Revert     *RevertReason `protobuf:"bytes,3,opt,name=revert,proto3" json:"revert,omitempty"`
+}
+
+// RevertReason Kind 为 error（Error(string)）、panic（Panic(uint256)）、custom（ABI 中的自定义错误）或 unknown；
+// Data 为原始 revert 数据，Args 仅自定义错误有，数组与 tuple 的 Value 为 JSON
+type RevertReason struct {
+	Kind      string      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message   string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature string      `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Selector  string      `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	Args      []RevertArg `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	Data      string      `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+type RevertArg struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 type FeeParam struct {
@@ -111,9 +212,16 @@ type Fee struct {
 }
 
 type GasFee struct {
-	GasPrice  string
-	GasTipCap string
-	MultiVal  string
+	GasPrice  string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasTipCap string `protobuf:"bytes,2,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
+	// Deprecated: 各档位已直接给出 MaxFeePerGas / MaxPriorityFeePerGas，不再返回倍数
+	MultiVal string `protobuf:"bytes,3,opt,name=multi_val,json=multiVal,proto3" json:"multi_val,omitempty"`
+	// GasLimit / MaxCost 仅请求中带了待估算的交易时返回，MaxCost = GasLimit * 该档位的最高 gas 单价（wei）
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	MaxCost  string `protobuf:"bytes,5,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	// EIP-1559 链的档位费用，legacy 链为空，只看 GasPrice
+	MaxFeePerGas         string `protobuf:"bytes,6,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `protobuf:"bytes,7,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
 }
 
 type AccountParam struct {
@@ -129,10 +237,105 @@ type AccountParam struct {
 type Account struct {
 	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
 	AccountNumber string `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Sequence      string `protobuf:"bytes,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Balance       string `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Sequence 下一笔交易建议使用的 nonce，查询本身不占用，并发调用可能得到相同的值
+	Sequence string `protobuf:"bytes,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Balance  string `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	// AccountType 账户类型（EVM：eoa / contract / delegated_eoa），Delegate 为 EIP-7702 委托的合约地址
+	AccountType string `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Delegate    string `protobuf:"bytes,8,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// Decimals / Symbol 余额对应的原生币或代币信息，取不到代币信息时 Symbol 为空
+	Decimals uint32 `protobuf:"varint,9,opt,name=decimals,proto3" json:"decimals"`
+	Symbol   string `protobuf:"bytes,10,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Address 为查询的地址，请求中传入 ENS 名称时为解析出的地址，Name 为该名称
+	Address string `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+type TokenInfoParam struct {
+	ConsumerToken   string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain           string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network         string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+// TokenInfo 代币元数据，原生币的 ContractAddress 为零地址
+type TokenInfo struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol          string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals        uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals"`
+}
+
+// BalancesParam 批量查询余额，Height 为 0 时查询最新区块
+type BalancesParam struct {
+	ConsumerToken string         `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string         `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string         `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Queries       []BalanceQuery `protobuf:"bytes,4,rep,name=queries,proto3" json:"queries,omitempty"`
+	Height        uint64         `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+// BalanceQuery ContractAddress 为空时查询原生币余额
+type BalanceQuery struct {
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
+// Balances Height 为实际查询的区块高度，Balances 与查询顺序一致
+type Balances struct {
+	Height   uint64         `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Balances []TokenBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+// TokenBalance 单个查询失败时 Balance 为空，Error 为失败原因
+type TokenBalance struct {
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Balance         string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Error           string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Decimals        uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals"`
+	Symbol          string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Name 查询地址为 ENS 名称时为该名称，Address 为解析出的地址
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+type NonceStatusParam struct {
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+// NonceStatus 账户的 nonce 使用情况：LatestNonce 为已上链交易数，PendingNonce 含交易池，
+// NextNonce 为下一笔交易应使用的 nonce（跳过本服务已分配、尚未上链的 nonce）；
+// Gaps 为在途交易之前未被占用的 nonce，补齐前其后的交易无法上链；Stuck 为已广播但长时间未上链的 nonce
+type NonceStatus struct {
+	LatestNonce  uint64          `protobuf:"varint,1,opt,name=latest_nonce,json=latestNonce,proto3" json:"latest_nonce"`
+	PendingNonce uint64          `protobuf:"varint,2,opt,name=pending_nonce,json=pendingNonce,proto3" json:"pending_nonce"`
+	NextNonce    uint64          `protobuf:"varint,3,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce"`
+	InFlight     []InFlightNonce `protobuf:"bytes,4,rep,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	Gaps         []uint64        `protobuf:"varint,5,rep,packed,name=gaps,proto3" json:"gaps,omitempty"`
+	Stuck        []uint64        `protobuf:"varint,6,rep,packed,name=stuck,proto3" json:"stuck,omitempty"`
+	// Address 为查询的地址，请求中传入 ENS 名称时为解析出的地址，Name 为该名称
+	Address string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+// InFlightNonce 本服务已分配、尚未上链的 nonce，Age 为分配至今的秒数
+type InFlightNonce struct {
+	Nonce  uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce"`
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	State  string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Age    uint64 `protobuf:"varint,4,opt,name=age,proto3" json:"age"`
+}
+
+// InFlightNonce.State 的取值
+const (
+	NonceStateReserved  = "reserved"
+	NonceStateSigned    = "signed"
+	NonceStateBroadcast = "broadcast"
+)
+
 type BlockHeaderByRangeParam struct {
 	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
 	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -162,6 +365,7 @@ type BlockHeader struct {
 	WithdrawalsHash  string `protobuf:"bytes,18,opt,name=withdrawals_hash,json=withdrawalsHash,proto3" json:"withdrawals_hash,omitempty"`
 	BlobGasUsed      uint64 `protobuf:"varint,19,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
 	ExcessBlobGas    uint64 `protobuf:"varint,20,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
+	BlobBaseFee      string `protobuf:"bytes,21,opt,name=blob_base_fee,json=blobBaseFee,proto3" json:"blob_base_fee,omitempty"`
 }
 
 type BlockHeaderNumberParam struct {
@@ -197,6 +401,8 @@ type Block struct {
 	Hash         string              `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	BaseFee      string              `protobuf:"bytes,5,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
 	Transactions []*BlockTransaction `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// BlobBaseFee 由 excessBlobGas 计算出的区块 blob 基础费用，不支持 EIP-4844 的链为空
+	BlobBaseFee string `protobuf:"bytes,7,opt,name=blob_base_fee,json=blobBaseFee,proto3" json:"blob_base_fee,omitempty"`
 }
 
 type BlockTransaction struct {
@@ -207,6 +413,8 @@ type BlockTransaction struct {
 	Hash           string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
 	Height         uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	Amount         string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals       uint32 `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals"`
+	Symbol         string `protobuf:"bytes,9,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
 type ValidAddressParam struct {
@@ -216,6 +424,37 @@ type ValidAddressParam struct {
 	Address       string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 }
 
+// AddressValidation Checksummed 表示地址带有正确的大小写校验和（EVM 为 EIP-55），
+// Address 为规范形式（EVM 为 EIP-55 校验和地址），Reason 为地址不合法的原因；
+// 传入 ENS 名称时 Address 为解析出的地址，Name 为规范化后的名称
+type AddressValidation struct {
+	Valid       bool   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid"`
+	Checksummed bool   `protobuf:"varint,2,opt,name=checksummed,proto3" json:"checksummed"`
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Name        string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+type EnsNameParam struct {
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+type EnsAddressParam struct {
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+// EnsRecord ENS 名称（规范化后）与其指向的地址
+type EnsRecord struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
 type SupportChainsParam struct {
 	ConsumerToken string `json:"consumer_token,omitempty"`
 	Chain         string `json:"chain,omitempty"`
@@ -279,5 +518,61 @@ const (
 	OpChinId               uint64 = 10
 	OpTestChinId           uint64 = 11155420
 	LineaChainId           uint64 = 59144
+	ArbitrumChainId        uint64 = 42161
+	BscChainId             uint64 = 56
+	ZksyncChainId          uint64 = 324
+	PolygonPosChainId      uint64 = 137
 	BlocksLimit                   = 10000
 )
+
+// WalletAccountService 方法名，用于能力清单 ChainCapability.Methods
+const (
+	MethodConvertAddress               = "ConvertAddress"
+	MethodValidAddress                 = "ValidAddress"
+	MethodGetBlockByNumber             = "GetBlockByNumber"
+	MethodGetBlockByHash               = "GetBlockByHash"
+	MethodGetBlockHeaderByHash         = "GetBlockHeaderByHash"
+	MethodGetBlockHeaderByNumber       = "GetBlockHeaderByNumber"
+	MethodListBlockHeaderByRange       = "ListBlockHeaderByRange"
+	MethodGetAccount                   = "GetAccount"
+	MethodGetFee                       = "GetFee"
+	MethodSendTx                       = "SendTx"
+	MethodListTxByAddress              = "ListTxByAddress"
+	MethodGetTxByHash                  = "GetTxByHash"
+	MethodCreateUnSignTransaction      = "CreateUnSignTransaction"
+	MethodBuildSignedTransaction       = "BuildSignedTransaction"
+	MethodDecodeTransaction            = "DecodeTransaction"
+	MethodVerifySignedTransaction      = "VerifySignedTransaction"
+	MethodGetExtraData                 = "GetExtraData"
+	MethodCreateUnSignAuthorization    = "CreateUnSignAuthorization"
+	MethodGetNonceStatus               = "GetNonceStatus"
+	MethodCreateReplacementTransaction = "CreateReplacementTransaction"
+	MethodSimulateTransaction          = "SimulateTransaction"
+	MethodGetBalances                  = "GetBalances"
+	MethodGetTokenInfo                 = "GetTokenInfo"
+	MethodResolveEnsName               = "ResolveEnsName"
+	MethodLookupEnsName                = "LookupEnsName"
+)
+
+// 交易种类，用于能力清单 ChainCapability.TxKinds
+const (
+	TxKindNative       = "native"
+	TxKindToken        = "token"
+	TxKindNFT          = "nft"
+	TxKindContractCall = "contract_call"
+)
+
+// ChainCapabilitiesParam Chain 为空时返回所有已注册链的能力清单
+type ChainCapabilitiesParam struct {
+	ConsumerToken string `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+// ChainCapability 某条链实际实现的方法及可构造的交易种类
+type ChainCapability struct {
+	Chain   string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network string   `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Methods []string `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	TxKinds []string `protobuf:"bytes,4,rep,name=tx_kinds,json=txKinds,proto3" json:"tx_kinds,omitempty"`
+}
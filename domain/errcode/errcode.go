@@ -0,0 +1,107 @@
+package errcode
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code 错误分类，gRPC / HTTP 层以及调用方按 Code 分支处理，而不是匹配错误文本
+type Code int32
+
+const (
+	Unknown Code = iota
+	NotFound
+	InvalidArgument
+	Unsupported
+	UpstreamUnavailable
+	SignatureMismatch
+	InsufficientFunds
+	Unauthenticated
+	PermissionDenied
+	Unimplemented
+)
+
+var codeNames = map[Code]string{
+	Unknown:             "Unknown",
+	NotFound:            "NotFound",
+	InvalidArgument:     "InvalidArgument",
+	Unsupported:         "Unsupported",
+	UpstreamUnavailable: "UpstreamUnavailable",
+	SignatureMismatch:   "SignatureMismatch",
+	InsufficientFunds:   "InsufficientFunds",
+	Unauthenticated:     "Unauthenticated",
+	PermissionDenied:    "PermissionDenied",
+	Unimplemented:       "Unimplemented",
+}
+
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Code(%d)", int32(c))
+}
+
+// 各分类的哨兵错误，用于 errors.Is(err, errcode.ErrNotFound) 判断
+var (
+	ErrNotFound            = &Error{Code: NotFound}
+	ErrInvalidArgument     = &Error{Code: InvalidArgument}
+	ErrUnsupported         = &Error{Code: Unsupported}
+	ErrUpstreamUnavailable = &Error{Code: UpstreamUnavailable}
+	ErrSignatureMismatch   = &Error{Code: SignatureMismatch}
+	ErrInsufficientFunds   = &Error{Code: InsufficientFunds}
+	ErrUnauthenticated     = &Error{Code: Unauthenticated}
+	ErrPermissionDenied    = &Error{Code: PermissionDenied}
+	ErrUnimplemented       = &Error{Code: Unimplemented}
+)
+
+// Error 带分类的错误，Err 为底层原始错误（可为空）
+type Error struct {
+	Code Code
+	Msg  string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	msg := e.Msg
+	if msg == "" {
+		msg = e.Code.String()
+	}
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is 目标为哨兵错误（无 Msg、无 Err）时按 Code 匹配
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok || t.Msg != "" || t.Err != nil {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+func New(code Code, format string, args ...any) *Error {
+	return &Error{Code: code, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Wrap 为 err 打上分类，err 为 nil 时返回 nil
+func Wrap(code Code, err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Msg: fmt.Sprintf(format, args...), Err: err}
+}
+
+// CodeOf 取错误链上第一个 *Error 的 Code，没有则返回 Unknown
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return Unknown
+}
@@ -0,0 +1,5069 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: account.proto
+
+package account
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TxStatus int32
+
+const (
+	TxStatus_NotFound              TxStatus = 0
+	TxStatus_Pending               TxStatus = 1
+	TxStatus_Failed                TxStatus = 2
+	TxStatus_Success               TxStatus = 3
+	TxStatus_ContractExecuteFailed TxStatus = 4
+	TxStatus_Other                 TxStatus = 5
+)
+
+// Enum value maps for TxStatus.
+var (
+	TxStatus_name = map[int32]string{
+		0: "NotFound",
+		1: "Pending",
+		2: "Failed",
+		3: "Success",
+		4: "ContractExecuteFailed",
+		5: "Other",
+	}
+	TxStatus_value = map[string]int32{
+		"NotFound":              0,
+		"Pending":               1,
+		"Failed":                2,
+		"Success":               3,
+		"ContractExecuteFailed": 4,
+		"Other":                 5,
+	}
+)
+
+func (x TxStatus) Enum() *TxStatus {
+	p := new(TxStatus)
+	*p = x
+	return p
+}
+
+func (x TxStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_account_proto_enumTypes[0].Descriptor()
+}
+
+func (TxStatus) Type() protoreflect.EnumType {
+	return &file_account_proto_enumTypes[0]
+}
+
+func (x TxStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxStatus.Descriptor instead.
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{0}
+}
+
+type SupportChainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportChainsRequest) Reset() {
+	*x = SupportChainsRequest{}
+	mi := &file_account_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportChainsRequest) ProtoMessage() {}
+
+func (x *SupportChainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportChainsRequest.ProtoReflect.Descriptor instead.
+func (*SupportChainsRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SupportChainsRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SupportChainsRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *SupportChainsRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type SupportChainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Support       bool                   `protobuf:"varint,1,opt,name=support,proto3" json:"support,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportChainsResponse) Reset() {
+	*x = SupportChainsResponse{}
+	mi := &file_account_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportChainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportChainsResponse) ProtoMessage() {}
+
+func (x *SupportChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportChainsResponse.ProtoReflect.Descriptor instead.
+func (*SupportChainsResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SupportChainsResponse) GetSupport() bool {
+	if x != nil {
+		return x.Support
+	}
+	return false
+}
+
+type ChainCapabilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainCapabilitiesRequest) Reset() {
+	*x = ChainCapabilitiesRequest{}
+	mi := &file_account_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainCapabilitiesRequest) ProtoMessage() {}
+
+func (x *ChainCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*ChainCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChainCapabilitiesRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ChainCapabilitiesRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ChainCapabilitiesRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type ChainCapability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chain         string                 `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Methods       []string               `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	TxKinds       []string               `protobuf:"bytes,4,rep,name=tx_kinds,json=txKinds,proto3" json:"tx_kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainCapability) Reset() {
+	*x = ChainCapability{}
+	mi := &file_account_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainCapability) ProtoMessage() {}
+
+func (x *ChainCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainCapability.ProtoReflect.Descriptor instead.
+func (*ChainCapability) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChainCapability) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ChainCapability) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ChainCapability) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *ChainCapability) GetTxKinds() []string {
+	if x != nil {
+		return x.TxKinds
+	}
+	return nil
+}
+
+type ChainCapabilitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capabilities  []*ChainCapability     `protobuf:"bytes,1,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainCapabilitiesResponse) Reset() {
+	*x = ChainCapabilitiesResponse{}
+	mi := &file_account_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainCapabilitiesResponse) ProtoMessage() {}
+
+func (x *ChainCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*ChainCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChainCapabilitiesResponse) GetCapabilities() []*ChainCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ConvertAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAddressRequest) Reset() {
+	*x = ConvertAddressRequest{}
+	mi := &file_account_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAddressRequest) ProtoMessage() {}
+
+func (x *ConvertAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAddressRequest.ProtoReflect.Descriptor instead.
+func (*ConvertAddressRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConvertAddressRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ConvertAddressRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ConvertAddressRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ConvertAddressRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConvertAddressRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type ConvertAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertAddressResponse) Reset() {
+	*x = ConvertAddressResponse{}
+	mi := &file_account_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertAddressResponse) ProtoMessage() {}
+
+func (x *ConvertAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertAddressResponse.ProtoReflect.Descriptor instead.
+func (*ConvertAddressResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConvertAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ValidAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidAddressRequest) Reset() {
+	*x = ValidAddressRequest{}
+	mi := &file_account_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidAddressRequest) ProtoMessage() {}
+
+func (x *ValidAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidAddressRequest.ProtoReflect.Descriptor instead.
+func (*ValidAddressRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ValidAddressRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ValidAddressRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ValidAddressRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ValidAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ValidAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Checksummed   bool                   `protobuf:"varint,2,opt,name=checksummed,proto3" json:"checksummed,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidAddressResponse) Reset() {
+	*x = ValidAddressResponse{}
+	mi := &file_account_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidAddressResponse) ProtoMessage() {}
+
+func (x *ValidAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidAddressResponse.ProtoReflect.Descriptor instead.
+func (*ValidAddressResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidAddressResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidAddressResponse) GetChecksummed() bool {
+	if x != nil {
+		return x.Checksummed
+	}
+	return false
+}
+
+func (x *ValidAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidAddressResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ValidAddressResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BlockNumberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	ViewTx        bool                   `protobuf:"varint,4,opt,name=view_tx,json=viewTx,proto3" json:"view_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockNumberRequest) Reset() {
+	*x = BlockNumberRequest{}
+	mi := &file_account_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockNumberRequest) ProtoMessage() {}
+
+func (x *BlockNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockNumberRequest.ProtoReflect.Descriptor instead.
+func (*BlockNumberRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BlockNumberRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *BlockNumberRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *BlockNumberRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockNumberRequest) GetViewTx() bool {
+	if x != nil {
+		return x.ViewTx
+	}
+	return false
+}
+
+type BlockHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Hash          string                 `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	ViewTx        bool                   `protobuf:"varint,4,opt,name=view_tx,json=viewTx,proto3" json:"view_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHashRequest) Reset() {
+	*x = BlockHashRequest{}
+	mi := &file_account_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHashRequest) ProtoMessage() {}
+
+func (x *BlockHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHashRequest.ProtoReflect.Descriptor instead.
+func (*BlockHashRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BlockHashRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *BlockHashRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *BlockHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockHashRequest) GetViewTx() bool {
+	if x != nil {
+		return x.ViewTx
+	}
+	return false
+}
+
+type BlockTransaction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	From           string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TokenAddress   string                 `protobuf:"bytes,3,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
+	ContractWallet string                 `protobuf:"bytes,4,opt,name=contract_wallet,json=contractWallet,proto3" json:"contract_wallet,omitempty"`
+	Hash           string                 `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height         uint64                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Amount         string                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals       uint32                 `protobuf:"varint,8,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbol         string                 `protobuf:"bytes,9,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BlockTransaction) Reset() {
+	*x = BlockTransaction{}
+	mi := &file_account_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTransaction) ProtoMessage() {}
+
+func (x *BlockTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTransaction.ProtoReflect.Descriptor instead.
+func (*BlockTransaction) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BlockTransaction) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetTokenAddress() string {
+	if x != nil {
+		return x.TokenAddress
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetContractWallet() string {
+	if x != nil {
+		return x.ContractWallet
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *BlockTransaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *BlockTransaction) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type Block struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        int64                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Hash          string                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	BaseFee       string                 `protobuf:"bytes,5,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	Transactions  []*BlockTransaction    `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	BlobBaseFee   string                 `protobuf:"bytes,7,opt,name=blob_base_fee,json=blobBaseFee,proto3" json:"blob_base_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_account_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Block) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Block) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Block) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *Block) GetTransactions() []*BlockTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Block) GetBlobBaseFee() string {
+	if x != nil {
+		return x.BlobBaseFee
+	}
+	return ""
+}
+
+type BlockHeaderHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Hash          string                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHeaderHashRequest) Reset() {
+	*x = BlockHeaderHashRequest{}
+	mi := &file_account_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeaderHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeaderHashRequest) ProtoMessage() {}
+
+func (x *BlockHeaderHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeaderHashRequest.ProtoReflect.Descriptor instead.
+func (*BlockHeaderHashRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BlockHeaderHashRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *BlockHeaderHashRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *BlockHeaderHashRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *BlockHeaderHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type BlockHeaderNumberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHeaderNumberRequest) Reset() {
+	*x = BlockHeaderNumberRequest{}
+	mi := &file_account_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeaderNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeaderNumberRequest) ProtoMessage() {}
+
+func (x *BlockHeaderNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeaderNumberRequest.ProtoReflect.Descriptor instead.
+func (*BlockHeaderNumberRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BlockHeaderNumberRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *BlockHeaderNumberRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *BlockHeaderNumberRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *BlockHeaderNumberRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type BlockHeaderByRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHeaderByRangeRequest) Reset() {
+	*x = BlockHeaderByRangeRequest{}
+	mi := &file_account_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeaderByRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeaderByRangeRequest) ProtoMessage() {}
+
+func (x *BlockHeaderByRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeaderByRangeRequest.ProtoReflect.Descriptor instead.
+func (*BlockHeaderByRangeRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockHeaderByRangeRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *BlockHeaderByRangeRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *BlockHeaderByRangeRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *BlockHeaderByRangeRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *BlockHeaderByRangeRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type BlockHeader struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hash             string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash       string                 `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	UncleHash        string                 `protobuf:"bytes,3,opt,name=uncle_hash,json=uncleHash,proto3" json:"uncle_hash,omitempty"`
+	CoinBase         string                 `protobuf:"bytes,4,opt,name=coin_base,json=coinBase,proto3" json:"coin_base,omitempty"`
+	Root             string                 `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	TxHash           string                 `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	ReceiptHash      string                 `protobuf:"bytes,7,opt,name=receipt_hash,json=receiptHash,proto3" json:"receipt_hash,omitempty"`
+	ParentBeaconRoot string                 `protobuf:"bytes,8,opt,name=parent_beacon_root,json=parentBeaconRoot,proto3" json:"parent_beacon_root,omitempty"`
+	Difficulty       string                 `protobuf:"bytes,9,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Number           string                 `protobuf:"bytes,10,opt,name=number,proto3" json:"number,omitempty"`
+	GasLimit         uint64                 `protobuf:"varint,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasUsed          uint64                 `protobuf:"varint,12,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Time             uint64                 `protobuf:"varint,13,opt,name=time,proto3" json:"time,omitempty"`
+	Extra            string                 `protobuf:"bytes,14,opt,name=extra,proto3" json:"extra,omitempty"`
+	MixDigest        string                 `protobuf:"bytes,15,opt,name=mix_digest,json=mixDigest,proto3" json:"mix_digest,omitempty"`
+	Nonce            string                 `protobuf:"bytes,16,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BaseFee          string                 `protobuf:"bytes,17,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	WithdrawalsHash  string                 `protobuf:"bytes,18,opt,name=withdrawals_hash,json=withdrawalsHash,proto3" json:"withdrawals_hash,omitempty"`
+	BlobGasUsed      uint64                 `protobuf:"varint,19,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	ExcessBlobGas    uint64                 `protobuf:"varint,20,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
+	BlobBaseFee      string                 `protobuf:"bytes,21,opt,name=blob_base_fee,json=blobBaseFee,proto3" json:"blob_base_fee,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BlockHeader) Reset() {
+	*x = BlockHeader{}
+	mi := &file_account_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeader) ProtoMessage() {}
+
+func (x *BlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BlockHeader) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetParentHash() string {
+	if x != nil {
+		return x.ParentHash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetUncleHash() string {
+	if x != nil {
+		return x.UncleHash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetCoinBase() string {
+	if x != nil {
+		return x.CoinBase
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetReceiptHash() string {
+	if x != nil {
+		return x.ReceiptHash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetParentBeaconRoot() string {
+	if x != nil {
+		return x.ParentBeaconRoot
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetExtra() string {
+	if x != nil {
+		return x.Extra
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetMixDigest() string {
+	if x != nil {
+		return x.MixDigest
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetWithdrawalsHash() string {
+	if x != nil {
+		return x.WithdrawalsHash
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetBlobGasUsed() uint64 {
+	if x != nil {
+		return x.BlobGasUsed
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetExcessBlobGas() uint64 {
+	if x != nil {
+		return x.ExcessBlobGas
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetBlobBaseFee() string {
+	if x != nil {
+		return x.BlobBaseFee
+	}
+	return ""
+}
+
+type BlockHeaderByRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHeaders  []*BlockHeader         `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHeaderByRangeResponse) Reset() {
+	*x = BlockHeaderByRangeResponse{}
+	mi := &file_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeaderByRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeaderByRangeResponse) ProtoMessage() {}
+
+func (x *BlockHeaderByRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeaderByRangeResponse.ProtoReflect.Descriptor instead.
+func (*BlockHeaderByRangeResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BlockHeaderByRangeResponse) GetBlockHeaders() []*BlockHeader {
+	if x != nil {
+		return x.BlockHeaders
+	}
+	return nil
+}
+
+type AccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken    string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain            string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Coin             string                 `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Network          string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	Address          string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	ContractAddress  string                 `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	ProposerKeyIndex uint64                 `protobuf:"varint,7,opt,name=proposer_key_index,json=proposerKeyIndex,proto3" json:"proposer_key_index,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccountRequest) Reset() {
+	*x = AccountRequest{}
+	mi := &file_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRequest) ProtoMessage() {}
+
+func (x *AccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRequest.ProtoReflect.Descriptor instead.
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *AccountRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *AccountRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *AccountRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AccountRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AccountRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *AccountRequest) GetProposerKeyIndex() uint64 {
+	if x != nil {
+		return x.ProposerKeyIndex
+	}
+	return 0
+}
+
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Sequence      string                 `protobuf:"bytes,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Balance       string                 `protobuf:"bytes,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	AccountType   string                 `protobuf:"bytes,7,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Delegate      string                 `protobuf:"bytes,8,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,9,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbol        string                 `protobuf:"bytes,10,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Address       string                 `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	Name          string                 `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Account) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Account) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *Account) GetSequence() string {
+	if x != nil {
+		return x.Sequence
+	}
+	return ""
+}
+
+func (x *Account) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *Account) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Account) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *Account) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Account) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Account) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TokenInfoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken   string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain           string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network         string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenInfoRequest) Reset() {
+	*x = TokenInfoRequest{}
+	mi := &file_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenInfoRequest) ProtoMessage() {}
+
+func (x *TokenInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenInfoRequest.ProtoReflect.Descriptor instead.
+func (*TokenInfoRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TokenInfoRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *TokenInfoRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *TokenInfoRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *TokenInfoRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type TokenInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContractAddress string                 `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol          string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals        uint32                 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenInfo) Reset() {
+	*x = TokenInfo{}
+	mi := &file_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenInfo) ProtoMessage() {}
+
+func (x *TokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TokenInfo) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+type EnsNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnsNameRequest) Reset() {
+	*x = EnsNameRequest{}
+	mi := &file_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnsNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsNameRequest) ProtoMessage() {}
+
+func (x *EnsNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsNameRequest.ProtoReflect.Descriptor instead.
+func (*EnsNameRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EnsNameRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *EnsNameRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *EnsNameRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *EnsNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EnsAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnsAddressRequest) Reset() {
+	*x = EnsAddressRequest{}
+	mi := &file_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnsAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsAddressRequest) ProtoMessage() {}
+
+func (x *EnsAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsAddressRequest.ProtoReflect.Descriptor instead.
+func (*EnsAddressRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EnsAddressRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *EnsAddressRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *EnsAddressRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *EnsAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type EnsRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnsRecord) Reset() {
+	*x = EnsRecord{}
+	mi := &file_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnsRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsRecord) ProtoMessage() {}
+
+func (x *EnsRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsRecord.ProtoReflect.Descriptor instead.
+func (*EnsRecord) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EnsRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnsRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type BalanceQuery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BalanceQuery) Reset() {
+	*x = BalanceQuery{}
+	mi := &file_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceQuery) ProtoMessage() {}
+
+func (x *BalanceQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceQuery.ProtoReflect.Descriptor instead.
+func (*BalanceQuery) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BalanceQuery) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BalanceQuery) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+type BalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Queries       []*BalanceQuery        `protobuf:"bytes,4,rep,name=queries,proto3" json:"queries,omitempty"`
+	Height        uint64                 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalancesRequest) Reset() {
+	*x = BalancesRequest{}
+	mi := &file_account_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalancesRequest) ProtoMessage() {}
+
+func (x *BalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalancesRequest.ProtoReflect.Descriptor instead.
+func (*BalancesRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BalancesRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *BalancesRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *BalancesRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *BalancesRequest) GetQueries() []*BalanceQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *BalancesRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type TokenBalance struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Balance         string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Error           string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Decimals        uint32                 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbol          string                 `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenBalance) Reset() {
+	*x = TokenBalance{}
+	mi := &file_account_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenBalance) ProtoMessage() {}
+
+func (x *TokenBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenBalance.ProtoReflect.Descriptor instead.
+func (*TokenBalance) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TokenBalance) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenBalance) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Balances struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Balances      []*TokenBalance        `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balances) Reset() {
+	*x = Balances{}
+	mi := &file_account_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balances) ProtoMessage() {}
+
+func (x *Balances) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balances.ProtoReflect.Descriptor instead.
+func (*Balances) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Balances) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Balances) GetBalances() []*TokenBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type NonceStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NonceStatusRequest) Reset() {
+	*x = NonceStatusRequest{}
+	mi := &file_account_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NonceStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonceStatusRequest) ProtoMessage() {}
+
+func (x *NonceStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonceStatusRequest.ProtoReflect.Descriptor instead.
+func (*NonceStatusRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NonceStatusRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *NonceStatusRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *NonceStatusRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NonceStatusRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type InFlightNonce struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nonce         uint64                 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TxHash        string                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Age           uint64                 `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InFlightNonce) Reset() {
+	*x = InFlightNonce{}
+	mi := &file_account_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InFlightNonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightNonce) ProtoMessage() {}
+
+func (x *InFlightNonce) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InFlightNonce.ProtoReflect.Descriptor instead.
+func (*InFlightNonce) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InFlightNonce) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *InFlightNonce) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *InFlightNonce) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *InFlightNonce) GetAge() uint64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+type NonceStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatestNonce   uint64                 `protobuf:"varint,1,opt,name=latest_nonce,json=latestNonce,proto3" json:"latest_nonce,omitempty"`
+	PendingNonce  uint64                 `protobuf:"varint,2,opt,name=pending_nonce,json=pendingNonce,proto3" json:"pending_nonce,omitempty"`
+	NextNonce     uint64                 `protobuf:"varint,3,opt,name=next_nonce,json=nextNonce,proto3" json:"next_nonce,omitempty"`
+	InFlight      []*InFlightNonce       `protobuf:"bytes,4,rep,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	Gaps          []uint64               `protobuf:"varint,5,rep,packed,name=gaps,proto3" json:"gaps,omitempty"`
+	Stuck         []uint64               `protobuf:"varint,6,rep,packed,name=stuck,proto3" json:"stuck,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Name          string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NonceStatus) Reset() {
+	*x = NonceStatus{}
+	mi := &file_account_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NonceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NonceStatus) ProtoMessage() {}
+
+func (x *NonceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NonceStatus.ProtoReflect.Descriptor instead.
+func (*NonceStatus) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NonceStatus) GetLatestNonce() uint64 {
+	if x != nil {
+		return x.LatestNonce
+	}
+	return 0
+}
+
+func (x *NonceStatus) GetPendingNonce() uint64 {
+	if x != nil {
+		return x.PendingNonce
+	}
+	return 0
+}
+
+func (x *NonceStatus) GetNextNonce() uint64 {
+	if x != nil {
+		return x.NextNonce
+	}
+	return 0
+}
+
+func (x *NonceStatus) GetInFlight() []*InFlightNonce {
+	if x != nil {
+		return x.InFlight
+	}
+	return nil
+}
+
+func (x *NonceStatus) GetGaps() []uint64 {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+func (x *NonceStatus) GetStuck() []uint64 {
+	if x != nil {
+		return x.Stuck
+	}
+	return nil
+}
+
+func (x *NonceStatus) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NonceStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Coin          string                 `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Network       string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	RawTx         string                 `protobuf:"bytes,5,opt,name=rawTx,proto3" json:"rawTx,omitempty"`
+	Address       string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeRequest) Reset() {
+	*x = FeeRequest{}
+	mi := &file_account_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRequest) ProtoMessage() {}
+
+func (x *FeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeRequest.ProtoReflect.Descriptor instead.
+func (*FeeRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FeeRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *FeeRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *FeeRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *FeeRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *FeeRequest) GetRawTx() string {
+	if x != nil {
+		return x.RawTx
+	}
+	return ""
+}
+
+func (x *FeeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GasFee struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GasPrice  string                 `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasTipCap string                 `protobuf:"bytes,2,opt,name=gas_tip_cap,json=gasTipCap,proto3" json:"gas_tip_cap,omitempty"`
+	// Deprecated: Marked as deprecated in account.proto.
+	MultiVal             string `protobuf:"bytes,3,opt,name=multi_val,json=multiVal,proto3" json:"multi_val,omitempty"`
+	GasLimit             uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	MaxCost              string `protobuf:"bytes,5,opt,name=max_cost,json=maxCost,proto3" json:"max_cost,omitempty"`
+	MaxFeePerGas         string `protobuf:"bytes,6,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string `protobuf:"bytes,7,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GasFee) Reset() {
+	*x = GasFee{}
+	mi := &file_account_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GasFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasFee) ProtoMessage() {}
+
+func (x *GasFee) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasFee.ProtoReflect.Descriptor instead.
+func (*GasFee) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GasFee) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *GasFee) GetGasTipCap() string {
+	if x != nil {
+		return x.GasTipCap
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in account.proto.
+func (x *GasFee) GetMultiVal() string {
+	if x != nil {
+		return x.MultiVal
+	}
+	return ""
+}
+
+func (x *GasFee) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *GasFee) GetMaxCost() string {
+	if x != nil {
+		return x.MaxCost
+	}
+	return ""
+}
+
+func (x *GasFee) GetMaxFeePerGas() string {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return ""
+}
+
+func (x *GasFee) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+type Fee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlowFee       *GasFee                `protobuf:"bytes,3,opt,name=slow_fee,json=slowFee,proto3" json:"slow_fee,omitempty"`
+	NormalFee     *GasFee                `protobuf:"bytes,4,opt,name=normal_fee,json=normalFee,proto3" json:"normal_fee,omitempty"`
+	FastFee       *GasFee                `protobuf:"bytes,5,opt,name=fast_fee,json=fastFee,proto3" json:"fast_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fee) Reset() {
+	*x = Fee{}
+	mi := &file_account_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fee) ProtoMessage() {}
+
+func (x *Fee) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fee.ProtoReflect.Descriptor instead.
+func (*Fee) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Fee) GetSlowFee() *GasFee {
+	if x != nil {
+		return x.SlowFee
+	}
+	return nil
+}
+
+func (x *Fee) GetNormalFee() *GasFee {
+	if x != nil {
+		return x.NormalFee
+	}
+	return nil
+}
+
+func (x *Fee) GetFastFee() *GasFee {
+	if x != nil {
+		return x.FastFee
+	}
+	return nil
+}
+
+type SendTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Coin          string                 `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Network       string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	RawTx         string                 `protobuf:"bytes,5,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Simulate      bool                   `protobuf:"varint,6,opt,name=simulate,proto3" json:"simulate,omitempty"`
+	Abi           string                 `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTxRequest) Reset() {
+	*x = SendTxRequest{}
+	mi := &file_account_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTxRequest) ProtoMessage() {}
+
+func (x *SendTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTxRequest.ProtoReflect.Descriptor instead.
+func (*SendTxRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SendTxRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SendTxRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *SendTxRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *SendTxRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SendTxRequest) GetRawTx() string {
+	if x != nil {
+		return x.RawTx
+	}
+	return ""
+}
+
+func (x *SendTxRequest) GetSimulate() bool {
+	if x != nil {
+		return x.Simulate
+	}
+	return false
+}
+
+func (x *SendTxRequest) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+type SimulateTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	RawTx         string                 `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Abi           string                 `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateTransactionRequest) Reset() {
+	*x = SimulateTransactionRequest{}
+	mi := &file_account_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateTransactionRequest) ProtoMessage() {}
+
+func (x *SimulateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SimulateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SimulateTransactionRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SimulateTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *SimulateTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SimulateTransactionRequest) GetRawTx() string {
+	if x != nil {
+		return x.RawTx
+	}
+	return ""
+}
+
+func (x *SimulateTransactionRequest) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+type RevertArg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertArg) Reset() {
+	*x = RevertArg{}
+	mi := &file_account_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertArg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertArg) ProtoMessage() {}
+
+func (x *RevertArg) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertArg.ProtoReflect.Descriptor instead.
+func (*RevertArg) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevertArg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RevertArg) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RevertArg) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type RevertReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Selector      string                 `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	Args          []*RevertArg           `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	Data          string                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertReason) Reset() {
+	*x = RevertReason{}
+	mi := &file_account_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertReason) ProtoMessage() {}
+
+func (x *RevertReason) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertReason.ProtoReflect.Descriptor instead.
+func (*RevertReason) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RevertReason) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RevertReason) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevertReason) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *RevertReason) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *RevertReason) GetArgs() []*RevertArg {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *RevertReason) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type SimulationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ReturnData    string                 `protobuf:"bytes,2,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+	Revert        *RevertReason          `protobuf:"bytes,3,opt,name=revert,proto3" json:"revert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationResult) Reset() {
+	*x = SimulationResult{}
+	mi := &file_account_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationResult) ProtoMessage() {}
+
+func (x *SimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationResult.ProtoReflect.Descriptor instead.
+func (*SimulationResult) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SimulationResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SimulationResult) GetReturnData() string {
+	if x != nil {
+		return x.ReturnData
+	}
+	return ""
+}
+
+func (x *SimulationResult) GetRevert() *RevertReason {
+	if x != nil {
+		return x.Revert
+	}
+	return nil
+}
+
+type SendTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxHash        string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTxResponse) Reset() {
+	*x = SendTxResponse{}
+	mi := &file_account_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTxResponse) ProtoMessage() {}
+
+func (x *SendTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTxResponse.ProtoReflect.Descriptor instead.
+func (*SendTxResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SendTxResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type TxAddressRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken   string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain           string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Coin            string                 `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Network         string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	Address         string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	ContractAddress string                 `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Page            uint32                 `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	Pagesize        uint32                 `protobuf:"varint,8,opt,name=pagesize,proto3" json:"pagesize,omitempty"`
+	Cursor          string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TxAddressRequest) Reset() {
+	*x = TxAddressRequest{}
+	mi := &file_account_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxAddressRequest) ProtoMessage() {}
+
+func (x *TxAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxAddressRequest.ProtoReflect.Descriptor instead.
+func (*TxAddressRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TxAddressRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *TxAddressRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *TxAddressRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *TxAddressRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *TxAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TxAddressRequest) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TxAddressRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TxAddressRequest) GetPagesize() uint32 {
+	if x != nil {
+		return x.Pagesize
+	}
+	return 0
+}
+
+func (x *TxAddressRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type TxMessage struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Hash                string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index               uint32                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Froms               []string               `protobuf:"bytes,3,rep,name=froms,proto3" json:"froms,omitempty"`
+	Tos                 []string               `protobuf:"bytes,4,rep,name=tos,proto3" json:"tos,omitempty"`
+	Fee                 string                 `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Status              TxStatus               `protobuf:"varint,6,opt,name=status,proto3,enum=dapplink.account.TxStatus" json:"status,omitempty"`
+	Values              []string               `protobuf:"bytes,7,rep,name=values,proto3" json:"values,omitempty"`
+	Type                int32                  `protobuf:"varint,8,opt,name=type,proto3" json:"type,omitempty"`
+	Height              string                 `protobuf:"bytes,9,opt,name=height,proto3" json:"height,omitempty"`
+	ContractAddress     string                 `protobuf:"bytes,10,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Datetime            string                 `protobuf:"bytes,11,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Data                string                 `protobuf:"bytes,12,opt,name=data,proto3" json:"data,omitempty"`
+	TokenAddresses      []string               `protobuf:"bytes,13,rep,name=token_addresses,json=tokenAddresses,proto3" json:"token_addresses,omitempty"`
+	TokenIds            []string               `protobuf:"bytes,14,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	BlobVersionedHashes []string               `protobuf:"bytes,15,rep,name=blob_versioned_hashes,json=blobVersionedHashes,proto3" json:"blob_versioned_hashes,omitempty"`
+	MaxFeePerBlobGas    string                 `protobuf:"bytes,16,opt,name=max_fee_per_blob_gas,json=maxFeePerBlobGas,proto3" json:"max_fee_per_blob_gas,omitempty"`
+	BlobGasUsed         uint64                 `protobuf:"varint,17,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	BlobGasPrice        string                 `protobuf:"bytes,18,opt,name=blob_gas_price,json=blobGasPrice,proto3" json:"blob_gas_price,omitempty"`
+	BlobFee             string                 `protobuf:"bytes,19,opt,name=blob_fee,json=blobFee,proto3" json:"blob_fee,omitempty"`
+	Decimals            []uint32               `protobuf:"varint,20,rep,packed,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbols             []string               `protobuf:"bytes,21,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TxMessage) Reset() {
+	*x = TxMessage{}
+	mi := &file_account_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxMessage) ProtoMessage() {}
+
+func (x *TxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxMessage.ProtoReflect.Descriptor instead.
+func (*TxMessage) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TxMessage) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TxMessage) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TxMessage) GetFroms() []string {
+	if x != nil {
+		return x.Froms
+	}
+	return nil
+}
+
+func (x *TxMessage) GetTos() []string {
+	if x != nil {
+		return x.Tos
+	}
+	return nil
+}
+
+func (x *TxMessage) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *TxMessage) GetStatus() TxStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TxStatus_NotFound
+}
+
+func (x *TxMessage) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *TxMessage) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TxMessage) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+func (x *TxMessage) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *TxMessage) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
+}
+
+func (x *TxMessage) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *TxMessage) GetTokenAddresses() []string {
+	if x != nil {
+		return x.TokenAddresses
+	}
+	return nil
+}
+
+func (x *TxMessage) GetTokenIds() []string {
+	if x != nil {
+		return x.TokenIds
+	}
+	return nil
+}
+
+func (x *TxMessage) GetBlobVersionedHashes() []string {
+	if x != nil {
+		return x.BlobVersionedHashes
+	}
+	return nil
+}
+
+func (x *TxMessage) GetMaxFeePerBlobGas() string {
+	if x != nil {
+		return x.MaxFeePerBlobGas
+	}
+	return ""
+}
+
+func (x *TxMessage) GetBlobGasUsed() uint64 {
+	if x != nil {
+		return x.BlobGasUsed
+	}
+	return 0
+}
+
+func (x *TxMessage) GetBlobGasPrice() string {
+	if x != nil {
+		return x.BlobGasPrice
+	}
+	return ""
+}
+
+func (x *TxMessage) GetBlobFee() string {
+	if x != nil {
+		return x.BlobFee
+	}
+	return ""
+}
+
+func (x *TxMessage) GetDecimals() []uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return nil
+}
+
+func (x *TxMessage) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type TxAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tx            []*TxMessage           `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxAddressResponse) Reset() {
+	*x = TxAddressResponse{}
+	mi := &file_account_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxAddressResponse) ProtoMessage() {}
+
+func (x *TxAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxAddressResponse.ProtoReflect.Descriptor instead.
+func (*TxAddressResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TxAddressResponse) GetTx() []*TxMessage {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type TxHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Coin          string                 `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	Network       string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	Hash          string                 `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxHashRequest) Reset() {
+	*x = TxHashRequest{}
+	mi := &file_account_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxHashRequest) ProtoMessage() {}
+
+func (x *TxHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxHashRequest.ProtoReflect.Descriptor instead.
+func (*TxHashRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TxHashRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *TxHashRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *TxHashRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *TxHashRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *TxHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type UnSignTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Base64Tx      string                 `protobuf:"bytes,4,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnSignTransactionRequest) Reset() {
+	*x = UnSignTransactionRequest{}
+	mi := &file_account_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnSignTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnSignTransactionRequest) ProtoMessage() {}
+
+func (x *UnSignTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnSignTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UnSignTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnSignTransactionRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *UnSignTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *UnSignTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *UnSignTransactionRequest) GetBase64Tx() string {
+	if x != nil {
+		return x.Base64Tx
+	}
+	return ""
+}
+
+type ResolvedAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolvedAddress) Reset() {
+	*x = ResolvedAddress{}
+	mi := &file_account_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolvedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolvedAddress) ProtoMessage() {}
+
+func (x *ResolvedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolvedAddress.ProtoReflect.Descriptor instead.
+func (*ResolvedAddress) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResolvedAddress) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ResolvedAddress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResolvedAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type UnSignTransactionResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UnSignTx          string                 `protobuf:"bytes,1,opt,name=un_sign_tx,json=unSignTx,proto3" json:"un_sign_tx,omitempty"`
+	Base64Tx          string                 `protobuf:"bytes,2,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	ResolvedAddresses []*ResolvedAddress     `protobuf:"bytes,3,rep,name=resolved_addresses,json=resolvedAddresses,proto3" json:"resolved_addresses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UnSignTransactionResponse) Reset() {
+	*x = UnSignTransactionResponse{}
+	mi := &file_account_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnSignTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnSignTransactionResponse) ProtoMessage() {}
+
+func (x *UnSignTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnSignTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UnSignTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnSignTransactionResponse) GetUnSignTx() string {
+	if x != nil {
+		return x.UnSignTx
+	}
+	return ""
+}
+
+func (x *UnSignTransactionResponse) GetBase64Tx() string {
+	if x != nil {
+		return x.Base64Tx
+	}
+	return ""
+}
+
+func (x *UnSignTransactionResponse) GetResolvedAddresses() []*ResolvedAddress {
+	if x != nil {
+		return x.ResolvedAddresses
+	}
+	return nil
+}
+
+type ReplacementTransactionRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken        string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain                string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network              string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	TxHash               string                 `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Mode                 string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	MaxFeePerGas         string                 `protobuf:"bytes,6,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string                 `protobuf:"bytes,7,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	GasPrice             string                 `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReplacementTransactionRequest) Reset() {
+	*x = ReplacementTransactionRequest{}
+	mi := &file_account_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplacementTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplacementTransactionRequest) ProtoMessage() {}
+
+func (x *ReplacementTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplacementTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ReplacementTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReplacementTransactionRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetMaxFeePerGas() string {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionRequest) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+type ReplacementTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base64Tx      string                 `protobuf:"bytes,1,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	UnSignTx      string                 `protobuf:"bytes,2,opt,name=un_sign_tx,json=unSignTx,proto3" json:"un_sign_tx,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplacementTransactionResponse) Reset() {
+	*x = ReplacementTransactionResponse{}
+	mi := &file_account_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplacementTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplacementTransactionResponse) ProtoMessage() {}
+
+func (x *ReplacementTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplacementTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ReplacementTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReplacementTransactionResponse) GetBase64Tx() string {
+	if x != nil {
+		return x.Base64Tx
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionResponse) GetUnSignTx() string {
+	if x != nil {
+		return x.UnSignTx
+	}
+	return ""
+}
+
+func (x *ReplacementTransactionResponse) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+type UnSignAuthorizationRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken       string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain               string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network             string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Base64Authorization string                 `protobuf:"bytes,4,opt,name=base64_authorization,json=base64Authorization,proto3" json:"base64_authorization,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UnSignAuthorizationRequest) Reset() {
+	*x = UnSignAuthorizationRequest{}
+	mi := &file_account_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnSignAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnSignAuthorizationRequest) ProtoMessage() {}
+
+func (x *UnSignAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnSignAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*UnSignAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UnSignAuthorizationRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *UnSignAuthorizationRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *UnSignAuthorizationRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *UnSignAuthorizationRequest) GetBase64Authorization() string {
+	if x != nil {
+		return x.Base64Authorization
+	}
+	return ""
+}
+
+type UnSignAuthorizationResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UnSignAuthorization string                 `protobuf:"bytes,1,opt,name=un_sign_authorization,json=unSignAuthorization,proto3" json:"un_sign_authorization,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UnSignAuthorizationResponse) Reset() {
+	*x = UnSignAuthorizationResponse{}
+	mi := &file_account_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnSignAuthorizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnSignAuthorizationResponse) ProtoMessage() {}
+
+func (x *UnSignAuthorizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnSignAuthorizationResponse.ProtoReflect.Descriptor instead.
+func (*UnSignAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnSignAuthorizationResponse) GetUnSignAuthorization() string {
+	if x != nil {
+		return x.UnSignAuthorization
+	}
+	return ""
+}
+
+type SignedTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Base64Tx      string                 `protobuf:"bytes,4,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedTransactionRequest) Reset() {
+	*x = SignedTransactionRequest{}
+	mi := &file_account_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedTransactionRequest) ProtoMessage() {}
+
+func (x *SignedTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SignedTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SignedTransactionRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *SignedTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *SignedTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SignedTransactionRequest) GetBase64Tx() string {
+	if x != nil {
+		return x.Base64Tx
+	}
+	return ""
+}
+
+func (x *SignedTransactionRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SignedTransactionRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type SignedTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxHash        string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	SignedTx      string                 `protobuf:"bytes,2,opt,name=signed_tx,json=signedTx,proto3" json:"signed_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedTransaction) Reset() {
+	*x = SignedTransaction{}
+	mi := &file_account_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedTransaction) ProtoMessage() {}
+
+func (x *SignedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedTransaction.ProtoReflect.Descriptor instead.
+func (*SignedTransaction) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SignedTransaction) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *SignedTransaction) GetSignedTx() string {
+	if x != nil {
+		return x.SignedTx
+	}
+	return ""
+}
+
+type DecodeTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	RawTx         string                 `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Abi           string                 `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeTransactionRequest) Reset() {
+	*x = DecodeTransactionRequest{}
+	mi := &file_account_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionRequest) ProtoMessage() {}
+
+func (x *DecodeTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DecodeTransactionRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequest) GetRawTx() string {
+	if x != nil {
+		return x.RawTx
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequest) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+type DecodeTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base64Tx      string                 `protobuf:"bytes,1,opt,name=base64_tx,json=base64Tx,proto3" json:"base64_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeTransactionResponse) Reset() {
+	*x = DecodeTransactionResponse{}
+	mi := &file_account_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionResponse) ProtoMessage() {}
+
+func (x *DecodeTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DecodeTransactionResponse) GetBase64Tx() string {
+	if x != nil {
+		return x.Base64Tx
+	}
+	return ""
+}
+
+type VerifyTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	RawTx         string                 `protobuf:"bytes,6,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTransactionRequest) Reset() {
+	*x = VerifyTransactionRequest{}
+	mi := &file_account_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTransactionRequest) ProtoMessage() {}
+
+func (x *VerifyTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTransactionRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VerifyTransactionRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *VerifyTransactionRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *VerifyTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *VerifyTransactionRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *VerifyTransactionRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerifyTransactionRequest) GetRawTx() string {
+	if x != nil {
+		return x.RawTx
+	}
+	return ""
+}
+
+type VerifyTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verify        bool                   `protobuf:"varint,1,opt,name=verify,proto3" json:"verify,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTransactionResponse) Reset() {
+	*x = VerifyTransactionResponse{}
+	mi := &file_account_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTransactionResponse) ProtoMessage() {}
+
+func (x *VerifyTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTransactionResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *VerifyTransactionResponse) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+type ExtraDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerToken string                 `protobuf:"bytes,1,opt,name=consumer_token,json=consumerToken,proto3" json:"consumer_token,omitempty"`
+	Chain         string                 `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Network       string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Coin          string                 `protobuf:"bytes,5,opt,name=coin,proto3" json:"coin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtraDataRequest) Reset() {
+	*x = ExtraDataRequest{}
+	mi := &file_account_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtraDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtraDataRequest) ProtoMessage() {}
+
+func (x *ExtraDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtraDataRequest.ProtoReflect.Descriptor instead.
+func (*ExtraDataRequest) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExtraDataRequest) GetConsumerToken() string {
+	if x != nil {
+		return x.ConsumerToken
+	}
+	return ""
+}
+
+func (x *ExtraDataRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ExtraDataRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ExtraDataRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExtraDataRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+type ExtraDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtraDataResponse) Reset() {
+	*x = ExtraDataResponse{}
+	mi := &file_account_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtraDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtraDataResponse) ProtoMessage() {}
+
+func (x *ExtraDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_account_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtraDataResponse.ProtoReflect.Descriptor instead.
+func (*ExtraDataResponse) Descriptor() ([]byte, []int) {
+	return file_account_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ExtraDataResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_account_proto protoreflect.FileDescriptor
+
+const file_account_proto_rawDesc = "" +
+	"\n" +
+	"\raccount.proto\x12\x10dapplink.account\"m\n" +
+	"\x14SupportChainsRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\"1\n" +
+	"\x15SupportChainsResponse\x12\x18\n" +
+	"\asupport\x18\x01 \x01(\bR\asupport\"q\n" +
+	"\x18ChainCapabilitiesRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\"v\n" +
+	"\x0fChainCapability\x12\x14\n" +
+	"\x05chain\x18\x01 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x18\n" +
+	"\amethods\x18\x03 \x03(\tR\amethods\x12\x19\n" +
+	"\btx_kinds\x18\x04 \x03(\tR\atxKinds\"b\n" +
+	"\x19ChainCapabilitiesResponse\x12E\n" +
+	"\fcapabilities\x18\x01 \x03(\v2!.dapplink.account.ChainCapabilityR\fcapabilities\"\xa1\x01\n" +
+	"\x15ConvertAddressRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\tR\tpublicKey\"2\n" +
+	"\x16ConvertAddressResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x86\x01\n" +
+	"\x13ValidAddressRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"\x94\x01\n" +
+	"\x14ValidAddressResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12 \n" +
+	"\vchecksummed\x18\x02 \x01(\bR\vchecksummed\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\"\x82\x01\n" +
+	"\x12BlockNumberRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x17\n" +
+	"\aview_tx\x18\x04 \x01(\bR\x06viewTx\"|\n" +
+	"\x10BlockHashRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\tR\x04hash\x12\x17\n" +
+	"\aview_tx\x18\x04 \x01(\bR\x06viewTx\"\xfc\x01\n" +
+	"\x10BlockTransaction\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12#\n" +
+	"\rtoken_address\x18\x03 \x01(\tR\ftokenAddress\x12'\n" +
+	"\x0fcontract_wallet\x18\x04 \x01(\tR\x0econtractWallet\x12\x12\n" +
+	"\x04hash\x18\x05 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x04R\x06height\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12\x1a\n" +
+	"\bdecimals\x18\b \x01(\rR\bdecimals\x12\x16\n" +
+	"\x06symbol\x18\t \x01(\tR\x06symbol\"\xba\x01\n" +
+	"\x05Block\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x03R\x06height\x12\x12\n" +
+	"\x04hash\x18\x04 \x01(\tR\x04hash\x12\x19\n" +
+	"\bbase_fee\x18\x05 \x01(\tR\abaseFee\x12F\n" +
+	"\ftransactions\x18\x06 \x03(\v2\".dapplink.account.BlockTransactionR\ftransactions\x12\"\n" +
+	"\rblob_base_fee\x18\a \x01(\tR\vblobBaseFee\"\x83\x01\n" +
+	"\x16BlockHeaderHashRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04hash\x18\x04 \x01(\tR\x04hash\"\x89\x01\n" +
+	"\x18BlockHeaderNumberRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x03R\x06height\"\x9a\x01\n" +
+	"\x19BlockHeaderByRangeRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\"\x81\x05\n" +
+	"\vBlockHeader\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1f\n" +
+	"\vparent_hash\x18\x02 \x01(\tR\n" +
+	"parentHash\x12\x1d\n" +
+	"\n" +
+	"uncle_hash\x18\x03 \x01(\tR\tuncleHash\x12\x1b\n" +
+	"\tcoin_base\x18\x04 \x01(\tR\bcoinBase\x12\x12\n" +
+	"\x04root\x18\x05 \x01(\tR\x04root\x12\x17\n" +
+	"\atx_hash\x18\x06 \x01(\tR\x06txHash\x12!\n" +
+	"\freceipt_hash\x18\a \x01(\tR\vreceiptHash\x12,\n" +
+	"\x12parent_beacon_root\x18\b \x01(\tR\x10parentBeaconRoot\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\t \x01(\tR\n" +
+	"difficulty\x12\x16\n" +
+	"\x06number\x18\n" +
+	" \x01(\tR\x06number\x12\x1b\n" +
+	"\tgas_limit\x18\v \x01(\x04R\bgasLimit\x12\x19\n" +
+	"\bgas_used\x18\f \x01(\x04R\agasUsed\x12\x12\n" +
+	"\x04time\x18\r \x01(\x04R\x04time\x12\x14\n" +
+	"\x05extra\x18\x0e \x01(\tR\x05extra\x12\x1d\n" +
+	"\n" +
+	"mix_digest\x18\x0f \x01(\tR\tmixDigest\x12\x14\n" +
+	"\x05nonce\x18\x10 \x01(\tR\x05nonce\x12\x19\n" +
+	"\bbase_fee\x18\x11 \x01(\tR\abaseFee\x12)\n" +
+	"\x10withdrawals_hash\x18\x12 \x01(\tR\x0fwithdrawalsHash\x12\"\n" +
+	"\rblob_gas_used\x18\x13 \x01(\x04R\vblobGasUsed\x12&\n" +
+	"\x0fexcess_blob_gas\x18\x14 \x01(\x04R\rexcessBlobGas\x12\"\n" +
+	"\rblob_base_fee\x18\x15 \x01(\tR\vblobBaseFee\"`\n" +
+	"\x1aBlockHeaderByRangeResponse\x12B\n" +
+	"\rblock_headers\x18\x01 \x03(\v2\x1d.dapplink.account.BlockHeaderR\fblockHeaders\"\xee\x01\n" +
+	"\x0eAccountRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\tR\x04coin\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12)\n" +
+	"\x10contract_address\x18\x06 \x01(\tR\x0fcontractAddress\x12,\n" +
+	"\x12proposer_key_index\x18\a \x01(\x04R\x10proposerKeyIndex\"\xa1\x02\n" +
+	"\aAccount\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12%\n" +
+	"\x0eaccount_number\x18\x04 \x01(\tR\raccountNumber\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\tR\bsequence\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\tR\abalance\x12!\n" +
+	"\faccount_type\x18\a \x01(\tR\vaccountType\x12\x1a\n" +
+	"\bdelegate\x18\b \x01(\tR\bdelegate\x12\x1a\n" +
+	"\bdecimals\x18\t \x01(\rR\bdecimals\x12\x16\n" +
+	"\x06symbol\x18\n" +
+	" \x01(\tR\x06symbol\x12\x18\n" +
+	"\aaddress\x18\v \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\f \x01(\tR\x04name\"\x94\x01\n" +
+	"\x10TokenInfoRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12)\n" +
+	"\x10contract_address\x18\x04 \x01(\tR\x0fcontractAddress\"~\n" +
+	"\tTokenInfo\x12)\n" +
+	"\x10contract_address\x18\x01 \x01(\tR\x0fcontractAddress\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bdecimals\x18\x04 \x01(\rR\bdecimals\"{\n" +
+	"\x0eEnsNameRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"\x84\x01\n" +
+	"\x11EnsAddressRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"9\n" +
+	"\tEnsRecord\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\"S\n" +
+	"\fBalanceQuery\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\"\xba\x01\n" +
+	"\x0fBalancesRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x128\n" +
+	"\aqueries\x18\x04 \x03(\v2\x1e.dapplink.account.BalanceQueryR\aqueries\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x04R\x06height\"\xcb\x01\n" +
+	"\fTokenBalance\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12)\n" +
+	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1a\n" +
+	"\bdecimals\x18\x05 \x01(\rR\bdecimals\x12\x16\n" +
+	"\x06symbol\x18\x06 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\"^\n" +
+	"\bBalances\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\x12:\n" +
+	"\bbalances\x18\x02 \x03(\v2\x1e.dapplink.account.TokenBalanceR\bbalances\"\x85\x01\n" +
+	"\x12NonceStatusRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"f\n" +
+	"\rInFlightNonce\x12\x14\n" +
+	"\x05nonce\x18\x01 \x01(\x04R\x05nonce\x12\x17\n" +
+	"\atx_hash\x18\x02 \x01(\tR\x06txHash\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x10\n" +
+	"\x03age\x18\x04 \x01(\x04R\x03age\"\x8a\x02\n" +
+	"\vNonceStatus\x12!\n" +
+	"\flatest_nonce\x18\x01 \x01(\x04R\vlatestNonce\x12#\n" +
+	"\rpending_nonce\x18\x02 \x01(\x04R\fpendingNonce\x12\x1d\n" +
+	"\n" +
+	"next_nonce\x18\x03 \x01(\x04R\tnextNonce\x12<\n" +
+	"\tin_flight\x18\x04 \x03(\v2\x1f.dapplink.account.InFlightNonceR\binFlight\x12\x12\n" +
+	"\x04gaps\x18\x05 \x03(\x04R\x04gaps\x12\x14\n" +
+	"\x05stuck\x18\x06 \x03(\x04R\x05stuck\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\"\xa7\x01\n" +
+	"\n" +
+	"FeeRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\tR\x04coin\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x14\n" +
+	"\x05rawTx\x18\x05 \x01(\tR\x05rawTx\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\"\xfd\x01\n" +
+	"\x06GasFee\x12\x1b\n" +
+	"\tgas_price\x18\x01 \x01(\tR\bgasPrice\x12\x1e\n" +
+	"\vgas_tip_cap\x18\x02 \x01(\tR\tgasTipCap\x12\x1f\n" +
+	"\tmulti_val\x18\x03 \x01(\tB\x02\x18\x01R\bmultiVal\x12\x1b\n" +
+	"\tgas_limit\x18\x04 \x01(\x04R\bgasLimit\x12\x19\n" +
+	"\bmax_cost\x18\x05 \x01(\tR\amaxCost\x12%\n" +
+	"\x0fmax_fee_per_gas\x18\x06 \x01(\tR\fmaxFeePerGas\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\a \x01(\tR\x14maxPriorityFeePerGas\"\xa8\x01\n" +
+	"\x03Fee\x123\n" +
+	"\bslow_fee\x18\x03 \x01(\v2\x18.dapplink.account.GasFeeR\aslowFee\x127\n" +
+	"\n" +
+	"normal_fee\x18\x04 \x01(\v2\x18.dapplink.account.GasFeeR\tnormalFee\x123\n" +
+	"\bfast_fee\x18\x05 \x01(\v2\x18.dapplink.account.GasFeeR\afastFee\"\xbf\x01\n" +
+	"\rSendTxRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\tR\x04coin\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x15\n" +
+	"\x06raw_tx\x18\x05 \x01(\tR\x05rawTx\x12\x1a\n" +
+	"\bsimulate\x18\x06 \x01(\bR\bsimulate\x12\x10\n" +
+	"\x03abi\x18\a \x01(\tR\x03abi\"\x9c\x01\n" +
+	"\x1aSimulateTransactionRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x15\n" +
+	"\x06raw_tx\x18\x04 \x01(\tR\x05rawTx\x12\x10\n" +
+	"\x03abi\x18\x05 \x01(\tR\x03abi\"I\n" +
+	"\tRevertArg\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xbb\x01\n" +
+	"\fRevertReason\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1a\n" +
+	"\bselector\x18\x04 \x01(\tR\bselector\x12/\n" +
+	"\x04args\x18\x05 \x03(\v2\x1b.dapplink.account.RevertArgR\x04args\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\tR\x04data\"\x85\x01\n" +
+	"\x10SimulationResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vreturn_data\x18\x02 \x01(\tR\n" +
+	"returnData\x126\n" +
+	"\x06revert\x18\x03 \x01(\v2\x1e.dapplink.account.RevertReasonR\x06revert\")\n" +
+	"\x0eSendTxResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\"\x8a\x02\n" +
+	"\x10TxAddressRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\tR\x04coin\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12)\n" +
+	"\x10contract_address\x18\x06 \x01(\tR\x0fcontractAddress\x12\x12\n" +
+	"\x04page\x18\a \x01(\rR\x04page\x12\x1a\n" +
+	"\bpagesize\x18\b \x01(\rR\bpagesize\x12\x16\n" +
+	"\x06cursor\x18\t \x01(\tR\x06cursor\"\x87\x05\n" +
+	"\tTxMessage\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\x12\x14\n" +
+	"\x05froms\x18\x03 \x03(\tR\x05froms\x12\x10\n" +
+	"\x03tos\x18\x04 \x03(\tR\x03tos\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\tR\x03fee\x122\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1a.dapplink.account.TxStatusR\x06status\x12\x16\n" +
+	"\x06values\x18\a \x03(\tR\x06values\x12\x12\n" +
+	"\x04type\x18\b \x01(\x05R\x04type\x12\x16\n" +
+	"\x06height\x18\t \x01(\tR\x06height\x12)\n" +
+	"\x10contract_address\x18\n" +
+	" \x01(\tR\x0fcontractAddress\x12\x1a\n" +
+	"\bdatetime\x18\v \x01(\tR\bdatetime\x12\x12\n" +
+	"\x04data\x18\f \x01(\tR\x04data\x12'\n" +
+	"\x0ftoken_addresses\x18\r \x03(\tR\x0etokenAddresses\x12\x1b\n" +
+	"\ttoken_ids\x18\x0e \x03(\tR\btokenIds\x122\n" +
+	"\x15blob_versioned_hashes\x18\x0f \x03(\tR\x13blobVersionedHashes\x12.\n" +
+	"\x14max_fee_per_blob_gas\x18\x10 \x01(\tR\x10maxFeePerBlobGas\x12\"\n" +
+	"\rblob_gas_used\x18\x11 \x01(\x04R\vblobGasUsed\x12$\n" +
+	"\x0eblob_gas_price\x18\x12 \x01(\tR\fblobGasPrice\x12\x19\n" +
+	"\bblob_fee\x18\x13 \x01(\tR\ablobFee\x12\x1a\n" +
+	"\bdecimals\x18\x14 \x03(\rR\bdecimals\x12\x18\n" +
+	"\asymbols\x18\x15 \x03(\tR\asymbols\"@\n" +
+	"\x11TxAddressResponse\x12+\n" +
+	"\x02tx\x18\x01 \x03(\v2\x1b.dapplink.account.TxMessageR\x02tx\"\x8e\x01\n" +
+	"\rTxHashRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x12\n" +
+	"\x04coin\x18\x03 \x01(\tR\x04coin\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04hash\x18\x05 \x01(\tR\x04hash\"\x8e\x01\n" +
+	"\x18UnSignTransactionRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x1b\n" +
+	"\tbase64_tx\x18\x04 \x01(\tR\bbase64Tx\"U\n" +
+	"\x0fResolvedAddress\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"\xa8\x01\n" +
+	"\x19UnSignTransactionResponse\x12\x1c\n" +
+	"\n" +
+	"un_sign_tx\x18\x01 \x01(\tR\bunSignTx\x12\x1b\n" +
+	"\tbase64_tx\x18\x02 \x01(\tR\bbase64Tx\x12P\n" +
+	"\x12resolved_addresses\x18\x03 \x03(\v2!.dapplink.account.ResolvedAddressR\x11resolvedAddresses\"\x9f\x02\n" +
+	"\x1dReplacementTransactionRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x17\n" +
+	"\atx_hash\x18\x04 \x01(\tR\x06txHash\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12%\n" +
+	"\x0fmax_fee_per_gas\x18\x06 \x01(\tR\fmaxFeePerGas\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\a \x01(\tR\x14maxPriorityFeePerGas\x12\x1b\n" +
+	"\tgas_price\x18\b \x01(\tR\bgasPrice\"q\n" +
+	"\x1eReplacementTransactionResponse\x12\x1b\n" +
+	"\tbase64_tx\x18\x01 \x01(\tR\bbase64Tx\x12\x1c\n" +
+	"\n" +
+	"un_sign_tx\x18\x02 \x01(\tR\bunSignTx\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\x04R\x05nonce\"\xa6\x01\n" +
+	"\x1aUnSignAuthorizationRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x121\n" +
+	"\x14base64_authorization\x18\x04 \x01(\tR\x13base64Authorization\"Q\n" +
+	"\x1bUnSignAuthorizationResponse\x122\n" +
+	"\x15un_sign_authorization\x18\x01 \x01(\tR\x13unSignAuthorization\"\xcb\x01\n" +
+	"\x18SignedTransactionRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x1b\n" +
+	"\tbase64_tx\x18\x04 \x01(\tR\bbase64Tx\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x06 \x01(\tR\tpublicKey\"I\n" +
+	"\x11SignedTransaction\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12\x1b\n" +
+	"\tsigned_tx\x18\x02 \x01(\tR\bsignedTx\"\x9a\x01\n" +
+	"\x18DecodeTransactionRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x15\n" +
+	"\x06raw_tx\x18\x04 \x01(\tR\x05rawTx\x12\x10\n" +
+	"\x03abi\x18\x05 \x01(\tR\x03abi\"8\n" +
+	"\x19DecodeTransactionResponse\x12\x1b\n" +
+	"\tbase64_tx\x18\x01 \x01(\tR\bbase64Tx\"\xc5\x01\n" +
+	"\x18VerifyTransactionRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\tR\tsignature\x12\x15\n" +
+	"\x06raw_tx\x18\x06 \x01(\tR\x05rawTx\"3\n" +
+	"\x19VerifyTransactionResponse\x12\x16\n" +
+	"\x06verify\x18\x01 \x01(\bR\x06verify\"\x97\x01\n" +
+	"\x10ExtraDataRequest\x12%\n" +
+	"\x0econsumer_token\x18\x01 \x01(\tR\rconsumerToken\x12\x14\n" +
+	"\x05chain\x18\x02 \x01(\tR\x05chain\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04coin\x18\x05 \x01(\tR\x04coin\")\n" +
+	"\x11ExtraDataResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value*d\n" +
+	"\bTxStatus\x12\f\n" +
+	"\bNotFound\x10\x00\x12\v\n" +
+	"\aPending\x10\x01\x12\n" +
+	"\n" +
+	"\x06Failed\x10\x02\x12\v\n" +
+	"\aSuccess\x10\x03\x12\x19\n" +
+	"\x15ContractExecuteFailed\x10\x04\x12\t\n" +
+	"\x05Other\x10\x052\xde\x14\n" +
+	"\x14WalletAccountService\x12e\n" +
+	"\x10GetSupportChains\x12&.dapplink.account.SupportChainsRequest\x1a'.dapplink.account.SupportChainsResponse\"\x00\x12q\n" +
+	"\x14GetChainCapabilities\x12*.dapplink.account.ChainCapabilitiesRequest\x1a+.dapplink.account.ChainCapabilitiesResponse\"\x00\x12e\n" +
+	"\x0eConvertAddress\x12'.dapplink.account.ConvertAddressRequest\x1a(.dapplink.account.ConvertAddressResponse\"\x00\x12_\n" +
+	"\fValidAddress\x12%.dapplink.account.ValidAddressRequest\x1a&.dapplink.account.ValidAddressResponse\"\x00\x12S\n" +
+	"\x10GetBlockByNumber\x12$.dapplink.account.BlockNumberRequest\x1a\x17.dapplink.account.Block\"\x00\x12O\n" +
+	"\x0eGetBlockByHash\x12\".dapplink.account.BlockHashRequest\x1a\x17.dapplink.account.Block\"\x00\x12a\n" +
+	"\x14GetBlockHeaderByHash\x12(.dapplink.account.BlockHeaderHashRequest\x1a\x1d.dapplink.account.BlockHeader\"\x00\x12e\n" +
+	"\x16GetBlockHeaderByNumber\x12*.dapplink.account.BlockHeaderNumberRequest\x1a\x1d.dapplink.account.BlockHeader\"\x00\x12u\n" +
+	"\x16ListBlockHeaderByRange\x12+.dapplink.account.BlockHeaderByRangeRequest\x1a,.dapplink.account.BlockHeaderByRangeResponse\"\x00\x12K\n" +
+	"\n" +
+	"GetAccount\x12 .dapplink.account.AccountRequest\x1a\x19.dapplink.account.Account\"\x00\x12N\n" +
+	"\vGetBalances\x12!.dapplink.account.BalancesRequest\x1a\x1a.dapplink.account.Balances\"\x00\x12Q\n" +
+	"\fGetTokenInfo\x12\".dapplink.account.TokenInfoRequest\x1a\x1b.dapplink.account.TokenInfo\"\x00\x12Q\n" +
+	"\x0eResolveEnsName\x12 .dapplink.account.EnsNameRequest\x1a\x1b.dapplink.account.EnsRecord\"\x00\x12S\n" +
+	"\rLookupEnsName\x12#.dapplink.account.EnsAddressRequest\x1a\x1b.dapplink.account.EnsRecord\"\x00\x12W\n" +
+	"\x0eGetNonceStatus\x12$.dapplink.account.NonceStatusRequest\x1a\x1d.dapplink.account.NonceStatus\"\x00\x12?\n" +
+	"\x06GetFee\x12\x1c.dapplink.account.FeeRequest\x1a\x15.dapplink.account.Fee\"\x00\x12M\n" +
+	"\x06SendTx\x12\x1f.dapplink.account.SendTxRequest\x1a .dapplink.account.SendTxResponse\"\x00\x12i\n" +
+	"\x13SimulateTransaction\x12,.dapplink.account.SimulateTransactionRequest\x1a\".dapplink.account.SimulationResult\"\x00\x12\\\n" +
+	"\x0fListTxByAddress\x12\".dapplink.account.TxAddressRequest\x1a#.dapplink.account.TxAddressResponse\"\x00\x12M\n" +
+	"\vGetTxByHash\x12\x1f.dapplink.account.TxHashRequest\x1a\x1b.dapplink.account.TxMessage\"\x00\x12t\n" +
+	"\x17CreateUnSignTransaction\x12*.dapplink.account.UnSignTransactionRequest\x1a+.dapplink.account.UnSignTransactionResponse\"\x00\x12\x83\x01\n" +
+	"\x1cCreateReplacementTransaction\x12/.dapplink.account.ReplacementTransactionRequest\x1a0.dapplink.account.ReplacementTransactionResponse\"\x00\x12z\n" +
+	"\x19CreateUnSignAuthorization\x12,.dapplink.account.UnSignAuthorizationRequest\x1a-.dapplink.account.UnSignAuthorizationResponse\"\x00\x12k\n" +
+	"\x16BuildSignedTransaction\x12*.dapplink.account.SignedTransactionRequest\x1a#.dapplink.account.SignedTransaction\"\x00\x12n\n" +
+	"\x11DecodeTransaction\x12*.dapplink.account.DecodeTransactionRequest\x1a+.dapplink.account.DecodeTransactionResponse\"\x00\x12t\n" +
+	"\x17VerifySignedTransaction\x12*.dapplink.account.VerifyTransactionRequest\x1a+.dapplink.account.VerifyTransactionResponse\"\x00\x12Y\n" +
+	"\fGetExtraData\x12\".dapplink.account.ExtraDataRequest\x1a#.dapplink.account.ExtraDataResponse\"\x00B:Z8github.com/web3-fighter/wallet-chain-account/rpc/accountb\x06proto3"
+
+var (
+	file_account_proto_rawDescOnce sync.Once
+	file_account_proto_rawDescData []byte
+)
+
+func file_account_proto_rawDescGZIP() []byte {
+	file_account_proto_rawDescOnce.Do(func() {
+		file_account_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)))
+	})
+	return file_account_proto_rawDescData
+}
+
+var file_account_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_account_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_account_proto_goTypes = []any{
+	(TxStatus)(0),                          // 0: dapplink.account.TxStatus
+	(*SupportChainsRequest)(nil),           // 1: dapplink.account.SupportChainsRequest
+	(*SupportChainsResponse)(nil),          // 2: dapplink.account.SupportChainsResponse
+	(*ChainCapabilitiesRequest)(nil),       // 3: dapplink.account.ChainCapabilitiesRequest
+	(*ChainCapability)(nil),                // 4: dapplink.account.ChainCapability
+	(*ChainCapabilitiesResponse)(nil),      // 5: dapplink.account.ChainCapabilitiesResponse
+	(*ConvertAddressRequest)(nil),          // 6: dapplink.account.ConvertAddressRequest
+	(*ConvertAddressResponse)(nil),         // 7: dapplink.account.ConvertAddressResponse
+	(*ValidAddressRequest)(nil),            // 8: dapplink.account.ValidAddressRequest
+	(*ValidAddressResponse)(nil),           // 9: dapplink.account.ValidAddressResponse
+	(*BlockNumberRequest)(nil),             // 10: dapplink.account.BlockNumberRequest
+	(*BlockHashRequest)(nil),               // 11: dapplink.account.BlockHashRequest
+	(*BlockTransaction)(nil),               // 12: dapplink.account.BlockTransaction
+	(*Block)(nil),                          // 13: dapplink.account.Block
+	(*BlockHeaderHashRequest)(nil),         // 14: dapplink.account.BlockHeaderHashRequest
+	(*BlockHeaderNumberRequest)(nil),       // 15: dapplink.account.BlockHeaderNumberRequest
+	(*BlockHeaderByRangeRequest)(nil),      // 16: dapplink.account.BlockHeaderByRangeRequest
+	(*BlockHeader)(nil),                    // 17: dapplink.account.BlockHeader
+	(*BlockHeaderByRangeResponse)(nil),     // 18: dapplink.account.BlockHeaderByRangeResponse
+	(*AccountRequest)(nil),                 // 19: dapplink.account.AccountRequest
+	(*Account)(nil),                        // 20: dapplink.account.Account
+	(*TokenInfoRequest)(nil),               // 21: dapplink.account.TokenInfoRequest
+	(*TokenInfo)(nil),                      // 22: dapplink.account.TokenInfo
+	(*EnsNameRequest)(nil),                 // 23: dapplink.account.EnsNameRequest
+	(*EnsAddressRequest)(nil),              // 24: dapplink.account.EnsAddressRequest
+	(*EnsRecord)(nil),                      // 25: dapplink.account.EnsRecord
+	(*BalanceQuery)(nil),                   // 26: dapplink.account.BalanceQuery
+	(*BalancesRequest)(nil),                // 27: dapplink.account.BalancesRequest
+	(*TokenBalance)(nil),                   // 28: dapplink.account.TokenBalance
+	(*Balances)(nil),                       // 29: dapplink.account.Balances
+	(*NonceStatusRequest)(nil),             // 30: dapplink.account.NonceStatusRequest
+	(*InFlightNonce)(nil),                  // 31: dapplink.account.InFlightNonce
+	(*NonceStatus)(nil),                    // 32: dapplink.account.NonceStatus
+	(*FeeRequest)(nil),                     // 33: dapplink.account.FeeRequest
+	(*GasFee)(nil),                         // 34: dapplink.account.GasFee
+	(*Fee)(nil),                            // 35: dapplink.account.Fee
+	(*SendTxRequest)(nil),                  // 36: dapplink.account.SendTxRequest
+	(*SimulateTransactionRequest)(nil),     // 37: dapplink.account.SimulateTransactionRequest
+	(*RevertArg)(nil),                      // 38: dapplink.account.RevertArg
+	(*RevertReason)(nil),                   // 39: dapplink.account.RevertReason
+	(*SimulationResult)(nil),               // 40: dapplink.account.SimulationResult
+	(*SendTxResponse)(nil),                 // 41: dapplink.account.SendTxResponse
+	(*TxAddressRequest)(nil),               // 42: dapplink.account.TxAddressRequest
+	(*TxMessage)(nil),                      // 43: dapplink.account.TxMessage
+	(*TxAddressResponse)(nil),              // 44: dapplink.account.TxAddressResponse
+	(*TxHashRequest)(nil),                  // 45: dapplink.account.TxHashRequest
+	(*UnSignTransactionRequest)(nil),       // 46: dapplink.account.UnSignTransactionRequest
+	(*ResolvedAddress)(nil),                // 47: dapplink.account.ResolvedAddress
+	(*UnSignTransactionResponse)(nil),      // 48: dapplink.account.UnSignTransactionResponse
+	(*ReplacementTransactionRequest)(nil),  // 49: dapplink.account.ReplacementTransactionRequest
+	(*ReplacementTransactionResponse)(nil), // 50: dapplink.account.ReplacementTransactionResponse
+	(*UnSignAuthorizationRequest)(nil),     // 51: dapplink.account.UnSignAuthorizationRequest
+	(*UnSignAuthorizationResponse)(nil),    // 52: dapplink.account.UnSignAuthorizationResponse
+	(*SignedTransactionRequest)(nil),       // 53: dapplink.account.SignedTransactionRequest
+	(*SignedTransaction)(nil),              // 54: dapplink.account.SignedTransaction
+	(*DecodeTransactionRequest)(nil),       // 55: dapplink.account.DecodeTransactionRequest
+	(*DecodeTransactionResponse)(nil),      // 56: dapplink.account.DecodeTransactionResponse
+	(*VerifyTransactionRequest)(nil),       // 57: dapplink.account.VerifyTransactionRequest
+	(*VerifyTransactionResponse)(nil),      // 58: dapplink.account.VerifyTransactionResponse
+	(*ExtraDataRequest)(nil),               // 59: dapplink.account.ExtraDataRequest
+	(*ExtraDataResponse)(nil),              // 60: dapplink.account.ExtraDataResponse
+}
+var file_account_proto_depIdxs = []int32{
+	4,  // 0: dapplink.account.ChainCapabilitiesResponse.capabilities:type_name -> dapplink.account.ChainCapability
+	12, // 1: dapplink.account.Block.transactions:type_name -> dapplink.account.BlockTransaction
+	17, // 2: dapplink.account.BlockHeaderByRangeResponse.block_headers:type_name -> dapplink.account.BlockHeader
+	26, // 3: dapplink.account.BalancesRequest.queries:type_name -> dapplink.account.BalanceQuery
+	28, // 4: dapplink.account.Balances.balances:type_name -> dapplink.account.TokenBalance
+	31, // 5: dapplink.account.NonceStatus.in_flight:type_name -> dapplink.account.InFlightNonce
+	34, // 6: dapplink.account.Fee.slow_fee:type_name -> dapplink.account.GasFee
+	34, // 7: dapplink.account.Fee.normal_fee:type_name -> dapplink.account.GasFee
+	34, // 8: dapplink.account.Fee.fast_fee:type_name -> dapplink.account.GasFee
+	38, // 9: dapplink.account.RevertReason.args:type_name -> dapplink.account.RevertArg
+	39, // 10: dapplink.account.SimulationResult.revert:type_name -> dapplink.account.RevertReason
+	0,  // 11: dapplink.account.TxMessage.status:type_name -> dapplink.account.TxStatus
+	43, // 12: dapplink.account.TxAddressResponse.tx:type_name -> dapplink.account.TxMessage
+	47, // 13: dapplink.account.UnSignTransactionResponse.resolved_addresses:type_name -> dapplink.account.ResolvedAddress
+	1,  // 14: dapplink.account.WalletAccountService.GetSupportChains:input_type -> dapplink.account.SupportChainsRequest
+	3,  // 15: dapplink.account.WalletAccountService.GetChainCapabilities:input_type -> dapplink.account.ChainCapabilitiesRequest
+	6,  // 16: dapplink.account.WalletAccountService.ConvertAddress:input_type -> dapplink.account.ConvertAddressRequest
+	8,  // 17: dapplink.account.WalletAccountService.ValidAddress:input_type -> dapplink.account.ValidAddressRequest
+	10, // 18: dapplink.account.WalletAccountService.GetBlockByNumber:input_type -> dapplink.account.BlockNumberRequest
+	11, // 19: dapplink.account.WalletAccountService.GetBlockByHash:input_type -> dapplink.account.BlockHashRequest
+	14, // 20: dapplink.account.WalletAccountService.GetBlockHeaderByHash:input_type -> dapplink.account.BlockHeaderHashRequest
+	15, // 21: dapplink.account.WalletAccountService.GetBlockHeaderByNumber:input_type -> dapplink.account.BlockHeaderNumberRequest
+	16, // 22: dapplink.account.WalletAccountService.ListBlockHeaderByRange:input_type -> dapplink.account.BlockHeaderByRangeRequest
+	19, // 23: dapplink.account.WalletAccountService.GetAccount:input_type -> dapplink.account.AccountRequest
+	27, // 24: dapplink.account.WalletAccountService.GetBalances:input_type -> dapplink.account.BalancesRequest
+	21, // 25: dapplink.account.WalletAccountService.GetTokenInfo:input_type -> dapplink.account.TokenInfoRequest
+	23, // 26: dapplink.account.WalletAccountService.ResolveEnsName:input_type -> dapplink.account.EnsNameRequest
+	24, // 27: dapplink.account.WalletAccountService.LookupEnsName:input_type -> dapplink.account.EnsAddressRequest
+	30, // 28: dapplink.account.WalletAccountService.GetNonceStatus:input_type -> dapplink.account.NonceStatusRequest
+	33, // 29: dapplink.account.WalletAccountService.GetFee:input_type -> dapplink.account.FeeRequest
+	36, // 30: dapplink.account.WalletAccountService.SendTx:input_type -> dapplink.account.SendTxRequest
+	37, // 31: dapplink.account.WalletAccountService.SimulateTransaction:input_type -> dapplink.account.SimulateTransactionRequest
+	42, // 32: dapplink.account.WalletAccountService.ListTxByAddress:input_type -> dapplink.account.TxAddressRequest
+	45, // 33: dapplink.account.WalletAccountService.GetTxByHash:input_type -> dapplink.account.TxHashRequest
+	46, // 34: dapplink.account.WalletAccountService.CreateUnSignTransaction:input_type -> dapplink.account.UnSignTransactionRequest
+	49, // 35: dapplink.account.WalletAccountService.CreateReplacementTransaction:input_type -> dapplink.account.ReplacementTransactionRequest
+	51, // 36: dapplink.account.WalletAccountService.CreateUnSignAuthorization:input_type -> dapplink.account.UnSignAuthorizationRequest
+	53, // 37: dapplink.account.WalletAccountService.BuildSignedTransaction:input_type -> dapplink.account.SignedTransactionRequest
+	55, // 38: dapplink.account.WalletAccountService.DecodeTransaction:input_type -> dapplink.account.DecodeTransactionRequest
+	57, // 39: dapplink.account.WalletAccountService.VerifySignedTransaction:input_type -> dapplink.account.VerifyTransactionRequest
+	59, // 40: dapplink.account.WalletAccountService.GetExtraData:input_type -> dapplink.account.ExtraDataRequest
+	2,  // 41: dapplink.account.WalletAccountService.GetSupportChains:output_type -> dapplink.account.SupportChainsResponse
+	5,  // 42: dapplink.account.WalletAccountService.GetChainCapabilities:output_type -> dapplink.account.ChainCapabilitiesResponse
+	7,  // 43: dapplink.account.WalletAccountService.ConvertAddress:output_type -> dapplink.account.ConvertAddressResponse
+	9,  // 44: dapplink.account.WalletAccountService.ValidAddress:output_type -> dapplink.account.ValidAddressResponse
+	13, // 45: dapplink.account.WalletAccountService.GetBlockByNumber:output_type -> dapplink.account.Block
+	13, // 46: dapplink.account.WalletAccountService.GetBlockByHash:output_type -> dapplink.account.Block
+	17, // 47: dapplink.account.WalletAccountService.GetBlockHeaderByHash:output_type -> dapplink.account.BlockHeader
+	17, // 48: dapplink.account.WalletAccountService.GetBlockHeaderByNumber:output_type -> dapplink.account.BlockHeader
+	18, // 49: dapplink.account.WalletAccountService.ListBlockHeaderByRange:output_type -> dapplink.account.BlockHeaderByRangeResponse
+	20, // 50: dapplink.account.WalletAccountService.GetAccount:output_type -> dapplink.account.Account
+	29, // 51: dapplink.account.WalletAccountService.GetBalances:output_type -> dapplink.account.Balances
+	22, // 52: dapplink.account.WalletAccountService.GetTokenInfo:output_type -> dapplink.account.TokenInfo
+	25, // 53: dapplink.account.WalletAccountService.ResolveEnsName:output_type -> dapplink.account.EnsRecord
+	25, // 54: dapplink.account.WalletAccountService.LookupEnsName:output_type -> dapplink.account.EnsRecord
+	32, // 55: dapplink.account.WalletAccountService.GetNonceStatus:output_type -> dapplink.account.NonceStatus
+	35, // 56: dapplink.account.WalletAccountService.GetFee:output_type -> dapplink.account.Fee
+	41, // 57: dapplink.account.WalletAccountService.SendTx:output_type -> dapplink.account.SendTxResponse
+	40, // 58: dapplink.account.WalletAccountService.SimulateTransaction:output_type -> dapplink.account.SimulationResult
+	44, // 59: dapplink.account.WalletAccountService.ListTxByAddress:output_type -> dapplink.account.TxAddressResponse
+	43, // 60: dapplink.account.WalletAccountService.GetTxByHash:output_type -> dapplink.account.TxMessage
+	48, // 61: dapplink.account.WalletAccountService.CreateUnSignTransaction:output_type -> dapplink.account.UnSignTransactionResponse
+	50, // 62: dapplink.account.WalletAccountService.CreateReplacementTransaction:output_type -> dapplink.account.ReplacementTransactionResponse
+	52, // 63: dapplink.account.WalletAccountService.CreateUnSignAuthorization:output_type -> dapplink.account.UnSignAuthorizationResponse
+	54, // 64: dapplink.account.WalletAccountService.BuildSignedTransaction:output_type -> dapplink.account.SignedTransaction
+	56, // 65: dapplink.account.WalletAccountService.DecodeTransaction:output_type -> dapplink.account.DecodeTransactionResponse
+	58, // 66: dapplink.account.WalletAccountService.VerifySignedTransaction:output_type -> dapplink.account.VerifyTransactionResponse
+	60, // 67: dapplink.account.WalletAccountService.GetExtraData:output_type -> dapplink.account.ExtraDataResponse
+	41, // [41:68] is the sub-list for method output_type
+	14, // [14:41] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_account_proto_init() }
+func file_account_proto_init() {
+	if File_account_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_account_proto_rawDesc), len(file_account_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   60,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_account_proto_goTypes,
+		DependencyIndexes: file_account_proto_depIdxs,
+		EnumInfos:         file_account_proto_enumTypes,
+		MessageInfos:      file_account_proto_msgTypes,
+	}.Build()
+	File_account_proto = out.File
+	file_account_proto_goTypes = nil
+	file_account_proto_depIdxs = nil
+}
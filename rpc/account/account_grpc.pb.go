@@ -0,0 +1,1109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: account.proto
+
+package account
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WalletAccountService_GetSupportChains_FullMethodName             = "/dapplink.account.WalletAccountService/GetSupportChains"
+	WalletAccountService_GetChainCapabilities_FullMethodName         = "/dapplink.account.WalletAccountService/GetChainCapabilities"
+	WalletAccountService_ConvertAddress_FullMethodName               = "/dapplink.account.WalletAccountService/ConvertAddress"
+	WalletAccountService_ValidAddress_FullMethodName                 = "/dapplink.account.WalletAccountService/ValidAddress"
+	WalletAccountService_GetBlockByNumber_FullMethodName             = "/dapplink.account.WalletAccountService/GetBlockByNumber"
+	WalletAccountService_GetBlockByHash_FullMethodName               = "/dapplink.account.WalletAccountService/GetBlockByHash"
+	WalletAccountService_GetBlockHeaderByHash_FullMethodName         = "/dapplink.account.WalletAccountService/GetBlockHeaderByHash"
+	WalletAccountService_GetBlockHeaderByNumber_FullMethodName       = "/dapplink.account.WalletAccountService/GetBlockHeaderByNumber"
+	WalletAccountService_ListBlockHeaderByRange_FullMethodName       = "/dapplink.account.WalletAccountService/ListBlockHeaderByRange"
+	WalletAccountService_GetAccount_FullMethodName                   = "/dapplink.account.WalletAccountService/GetAccount"
+	WalletAccountService_GetBalances_FullMethodName                  = "/dapplink.account.WalletAccountService/GetBalances"
+	WalletAccountService_GetTokenInfo_FullMethodName                 = "/dapplink.account.WalletAccountService/GetTokenInfo"
+	WalletAccountService_ResolveEnsName_FullMethodName               = "/dapplink.account.WalletAccountService/ResolveEnsName"
+	WalletAccountService_LookupEnsName_FullMethodName                = "/dapplink.account.WalletAccountService/LookupEnsName"
+	WalletAccountService_GetNonceStatus_FullMethodName               = "/dapplink.account.WalletAccountService/GetNonceStatus"
+	WalletAccountService_GetFee_FullMethodName                       = "/dapplink.account.WalletAccountService/GetFee"
+	WalletAccountService_SendTx_FullMethodName                       = "/dapplink.account.WalletAccountService/SendTx"
+	WalletAccountService_SimulateTransaction_FullMethodName          = "/dapplink.account.WalletAccountService/SimulateTransaction"
+	WalletAccountService_ListTxByAddress_FullMethodName              = "/dapplink.account.WalletAccountService/ListTxByAddress"
+	WalletAccountService_GetTxByHash_FullMethodName                  = "/dapplink.account.WalletAccountService/GetTxByHash"
+	WalletAccountService_CreateUnSignTransaction_FullMethodName      = "/dapplink.account.WalletAccountService/CreateUnSignTransaction"
+	WalletAccountService_CreateReplacementTransaction_FullMethodName = "/dapplink.account.WalletAccountService/CreateReplacementTransaction"
+	WalletAccountService_CreateUnSignAuthorization_FullMethodName    = "/dapplink.account.WalletAccountService/CreateUnSignAuthorization"
+	WalletAccountService_BuildSignedTransaction_FullMethodName       = "/dapplink.account.WalletAccountService/BuildSignedTransaction"
+	WalletAccountService_DecodeTransaction_FullMethodName            = "/dapplink.account.WalletAccountService/DecodeTransaction"
+	WalletAccountService_VerifySignedTransaction_FullMethodName      = "/dapplink.account.WalletAccountService/VerifySignedTransaction"
+	WalletAccountService_GetExtraData_FullMethodName                 = "/dapplink.account.WalletAccountService/GetExtraData"
+)
+
+// WalletAccountServiceClient is the client API for WalletAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WalletAccountServiceClient interface {
+	GetSupportChains(ctx context.Context, in *SupportChainsRequest, opts ...grpc.CallOption) (*SupportChainsResponse, error)
+	GetChainCapabilities(ctx context.Context, in *ChainCapabilitiesRequest, opts ...grpc.CallOption) (*ChainCapabilitiesResponse, error)
+	ConvertAddress(ctx context.Context, in *ConvertAddressRequest, opts ...grpc.CallOption) (*ConvertAddressResponse, error)
+	ValidAddress(ctx context.Context, in *ValidAddressRequest, opts ...grpc.CallOption) (*ValidAddressResponse, error)
+	GetBlockByNumber(ctx context.Context, in *BlockNumberRequest, opts ...grpc.CallOption) (*Block, error)
+	GetBlockByHash(ctx context.Context, in *BlockHashRequest, opts ...grpc.CallOption) (*Block, error)
+	GetBlockHeaderByHash(ctx context.Context, in *BlockHeaderHashRequest, opts ...grpc.CallOption) (*BlockHeader, error)
+	GetBlockHeaderByNumber(ctx context.Context, in *BlockHeaderNumberRequest, opts ...grpc.CallOption) (*BlockHeader, error)
+	ListBlockHeaderByRange(ctx context.Context, in *BlockHeaderByRangeRequest, opts ...grpc.CallOption) (*BlockHeaderByRangeResponse, error)
+	GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Account, error)
+	GetBalances(ctx context.Context, in *BalancesRequest, opts ...grpc.CallOption) (*Balances, error)
+	GetTokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfo, error)
+	ResolveEnsName(ctx context.Context, in *EnsNameRequest, opts ...grpc.CallOption) (*EnsRecord, error)
+	LookupEnsName(ctx context.Context, in *EnsAddressRequest, opts ...grpc.CallOption) (*EnsRecord, error)
+	GetNonceStatus(ctx context.Context, in *NonceStatusRequest, opts ...grpc.CallOption) (*NonceStatus, error)
+	GetFee(ctx context.Context, in *FeeRequest, opts ...grpc.CallOption) (*Fee, error)
+	SendTx(ctx context.Context, in *SendTxRequest, opts ...grpc.CallOption) (*SendTxResponse, error)
+	SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResult, error)
+	ListTxByAddress(ctx context.Context, in *TxAddressRequest, opts ...grpc.CallOption) (*TxAddressResponse, error)
+	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxMessage, error)
+	CreateUnSignTransaction(ctx context.Context, in *UnSignTransactionRequest, opts ...grpc.CallOption) (*UnSignTransactionResponse, error)
+	CreateReplacementTransaction(ctx context.Context, in *ReplacementTransactionRequest, opts ...grpc.CallOption) (*ReplacementTransactionResponse, error)
+	CreateUnSignAuthorization(ctx context.Context, in *UnSignAuthorizationRequest, opts ...grpc.CallOption) (*UnSignAuthorizationResponse, error)
+	BuildSignedTransaction(ctx context.Context, in *SignedTransactionRequest, opts ...grpc.CallOption) (*SignedTransaction, error)
+	DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodeTransactionResponse, error)
+	VerifySignedTransaction(ctx context.Context, in *VerifyTransactionRequest, opts ...grpc.CallOption) (*VerifyTransactionResponse, error)
+	GetExtraData(ctx context.Context, in *ExtraDataRequest, opts ...grpc.CallOption) (*ExtraDataResponse, error)
+}
+
+type walletAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletAccountServiceClient(cc grpc.ClientConnInterface) WalletAccountServiceClient {
+	return &walletAccountServiceClient{cc}
+}
+
+func (c *walletAccountServiceClient) GetSupportChains(ctx context.Context, in *SupportChainsRequest, opts ...grpc.CallOption) (*SupportChainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupportChainsResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetSupportChains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetChainCapabilities(ctx context.Context, in *ChainCapabilitiesRequest, opts ...grpc.CallOption) (*ChainCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChainCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetChainCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) ConvertAddress(ctx context.Context, in *ConvertAddressRequest, opts ...grpc.CallOption) (*ConvertAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertAddressResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_ConvertAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) ValidAddress(ctx context.Context, in *ValidAddressRequest, opts ...grpc.CallOption) (*ValidAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidAddressResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_ValidAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetBlockByNumber(ctx context.Context, in *BlockNumberRequest, opts ...grpc.CallOption) (*Block, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Block)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetBlockByNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetBlockByHash(ctx context.Context, in *BlockHashRequest, opts ...grpc.CallOption) (*Block, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Block)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetBlockByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetBlockHeaderByHash(ctx context.Context, in *BlockHeaderHashRequest, opts ...grpc.CallOption) (*BlockHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockHeader)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetBlockHeaderByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetBlockHeaderByNumber(ctx context.Context, in *BlockHeaderNumberRequest, opts ...grpc.CallOption) (*BlockHeader, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockHeader)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetBlockHeaderByNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) ListBlockHeaderByRange(ctx context.Context, in *BlockHeaderByRangeRequest, opts ...grpc.CallOption) (*BlockHeaderByRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockHeaderByRangeResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_ListBlockHeaderByRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Account)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetBalances(ctx context.Context, in *BalancesRequest, opts ...grpc.CallOption) (*Balances, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Balances)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetTokenInfo(ctx context.Context, in *TokenInfoRequest, opts ...grpc.CallOption) (*TokenInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenInfo)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetTokenInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) ResolveEnsName(ctx context.Context, in *EnsNameRequest, opts ...grpc.CallOption) (*EnsRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnsRecord)
+	err := c.cc.Invoke(ctx, WalletAccountService_ResolveEnsName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) LookupEnsName(ctx context.Context, in *EnsAddressRequest, opts ...grpc.CallOption) (*EnsRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnsRecord)
+	err := c.cc.Invoke(ctx, WalletAccountService_LookupEnsName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetNonceStatus(ctx context.Context, in *NonceStatusRequest, opts ...grpc.CallOption) (*NonceStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NonceStatus)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetNonceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetFee(ctx context.Context, in *FeeRequest, opts ...grpc.CallOption) (*Fee, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fee)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) SendTx(ctx context.Context, in *SendTxRequest, opts ...grpc.CallOption) (*SendTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendTxResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_SendTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationResult)
+	err := c.cc.Invoke(ctx, WalletAccountService_SimulateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) ListTxByAddress(ctx context.Context, in *TxAddressRequest, opts ...grpc.CallOption) (*TxAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TxAddressResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_ListTxByAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TxMessage)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetTxByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) CreateUnSignTransaction(ctx context.Context, in *UnSignTransactionRequest, opts ...grpc.CallOption) (*UnSignTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnSignTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_CreateUnSignTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) CreateReplacementTransaction(ctx context.Context, in *ReplacementTransactionRequest, opts ...grpc.CallOption) (*ReplacementTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplacementTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_CreateReplacementTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) CreateUnSignAuthorization(ctx context.Context, in *UnSignAuthorizationRequest, opts ...grpc.CallOption) (*UnSignAuthorizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnSignAuthorizationResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_CreateUnSignAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) BuildSignedTransaction(ctx context.Context, in *SignedTransactionRequest, opts ...grpc.CallOption) (*SignedTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignedTransaction)
+	err := c.cc.Invoke(ctx, WalletAccountService_BuildSignedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodeTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodeTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_DecodeTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) VerifySignedTransaction(ctx context.Context, in *VerifyTransactionRequest, opts ...grpc.CallOption) (*VerifyTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_VerifySignedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletAccountServiceClient) GetExtraData(ctx context.Context, in *ExtraDataRequest, opts ...grpc.CallOption) (*ExtraDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtraDataResponse)
+	err := c.cc.Invoke(ctx, WalletAccountService_GetExtraData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletAccountServiceServer is the server API for WalletAccountService service.
+// All implementations must embed UnimplementedWalletAccountServiceServer
+// for forward compatibility.
+type WalletAccountServiceServer interface {
+	GetSupportChains(context.Context, *SupportChainsRequest) (*SupportChainsResponse, error)
+	GetChainCapabilities(context.Context, *ChainCapabilitiesRequest) (*ChainCapabilitiesResponse, error)
+	ConvertAddress(context.Context, *ConvertAddressRequest) (*ConvertAddressResponse, error)
+	ValidAddress(context.Context, *ValidAddressRequest) (*ValidAddressResponse, error)
+	GetBlockByNumber(context.Context, *BlockNumberRequest) (*Block, error)
+	GetBlockByHash(context.Context, *BlockHashRequest) (*Block, error)
+	GetBlockHeaderByHash(context.Context, *BlockHeaderHashRequest) (*BlockHeader, error)
+	GetBlockHeaderByNumber(context.Context, *BlockHeaderNumberRequest) (*BlockHeader, error)
+	ListBlockHeaderByRange(context.Context, *BlockHeaderByRangeRequest) (*BlockHeaderByRangeResponse, error)
+	GetAccount(context.Context, *AccountRequest) (*Account, error)
+	GetBalances(context.Context, *BalancesRequest) (*Balances, error)
+	GetTokenInfo(context.Context, *TokenInfoRequest) (*TokenInfo, error)
+	ResolveEnsName(context.Context, *EnsNameRequest) (*EnsRecord, error)
+	LookupEnsName(context.Context, *EnsAddressRequest) (*EnsRecord, error)
+	GetNonceStatus(context.Context, *NonceStatusRequest) (*NonceStatus, error)
+	GetFee(context.Context, *FeeRequest) (*Fee, error)
+	SendTx(context.Context, *SendTxRequest) (*SendTxResponse, error)
+	SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulationResult, error)
+	ListTxByAddress(context.Context, *TxAddressRequest) (*TxAddressResponse, error)
+	GetTxByHash(context.Context, *TxHashRequest) (*TxMessage, error)
+	CreateUnSignTransaction(context.Context, *UnSignTransactionRequest) (*UnSignTransactionResponse, error)
+	CreateReplacementTransaction(context.Context, *ReplacementTransactionRequest) (*ReplacementTransactionResponse, error)
+	CreateUnSignAuthorization(context.Context, *UnSignAuthorizationRequest) (*UnSignAuthorizationResponse, error)
+	BuildSignedTransaction(context.Context, *SignedTransactionRequest) (*SignedTransaction, error)
+	DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodeTransactionResponse, error)
+	VerifySignedTransaction(context.Context, *VerifyTransactionRequest) (*VerifyTransactionResponse, error)
+	GetExtraData(context.Context, *ExtraDataRequest) (*ExtraDataResponse, error)
+	mustEmbedUnimplementedWalletAccountServiceServer()
+}
+
+// UnimplementedWalletAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletAccountServiceServer struct{}
+
+func (UnimplementedWalletAccountServiceServer) GetSupportChains(context.Context, *SupportChainsRequest) (*SupportChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupportChains not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetChainCapabilities(context.Context, *ChainCapabilitiesRequest) (*ChainCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainCapabilities not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) ConvertAddress(context.Context, *ConvertAddressRequest) (*ConvertAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertAddress not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) ValidAddress(context.Context, *ValidAddressRequest) (*ValidAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidAddress not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetBlockByNumber(context.Context, *BlockNumberRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByNumber not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetBlockByHash(context.Context, *BlockHashRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetBlockHeaderByHash(context.Context, *BlockHeaderHashRequest) (*BlockHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetBlockHeaderByNumber(context.Context, *BlockHeaderNumberRequest) (*BlockHeader, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByNumber not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) ListBlockHeaderByRange(context.Context, *BlockHeaderByRangeRequest) (*BlockHeaderByRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockHeaderByRange not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetAccount(context.Context, *AccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetBalances(context.Context, *BalancesRequest) (*Balances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalances not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetTokenInfo(context.Context, *TokenInfoRequest) (*TokenInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenInfo not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) ResolveEnsName(context.Context, *EnsNameRequest) (*EnsRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEnsName not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) LookupEnsName(context.Context, *EnsAddressRequest) (*EnsRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupEnsName not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetNonceStatus(context.Context, *NonceStatusRequest) (*NonceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNonceStatus not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetFee(context.Context, *FeeRequest) (*Fee, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFee not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) SendTx(context.Context, *SendTxRequest) (*SendTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTx not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) ListTxByAddress(context.Context, *TxAddressRequest) (*TxAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTxByAddress not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetTxByHash(context.Context, *TxHashRequest) (*TxMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxByHash not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) CreateUnSignTransaction(context.Context, *UnSignTransactionRequest) (*UnSignTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUnSignTransaction not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) CreateReplacementTransaction(context.Context, *ReplacementTransactionRequest) (*ReplacementTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplacementTransaction not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) CreateUnSignAuthorization(context.Context, *UnSignAuthorizationRequest) (*UnSignAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUnSignAuthorization not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) BuildSignedTransaction(context.Context, *SignedTransactionRequest) (*SignedTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildSignedTransaction not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodeTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTransaction not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) VerifySignedTransaction(context.Context, *VerifyTransactionRequest) (*VerifyTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignedTransaction not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) GetExtraData(context.Context, *ExtraDataRequest) (*ExtraDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExtraData not implemented")
+}
+func (UnimplementedWalletAccountServiceServer) mustEmbedUnimplementedWalletAccountServiceServer() {}
+func (UnimplementedWalletAccountServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeWalletAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletAccountServiceServer will
+// result in compilation errors.
+type UnsafeWalletAccountServiceServer interface {
+	mustEmbedUnimplementedWalletAccountServiceServer()
+}
+
+func RegisterWalletAccountServiceServer(s grpc.ServiceRegistrar, srv WalletAccountServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWalletAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WalletAccountService_ServiceDesc, srv)
+}
+
+func _WalletAccountService_GetSupportChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetSupportChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetSupportChains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetSupportChains(ctx, req.(*SupportChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetChainCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetChainCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetChainCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetChainCapabilities(ctx, req.(*ChainCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_ConvertAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).ConvertAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_ConvertAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).ConvertAddress(ctx, req.(*ConvertAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_ValidAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).ValidAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_ValidAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).ValidAddress(ctx, req.(*ValidAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetBlockByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetBlockByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetBlockByNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetBlockByNumber(ctx, req.(*BlockNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetBlockByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetBlockByHash(ctx, req.(*BlockHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeaderHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetBlockHeaderByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetBlockHeaderByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetBlockHeaderByHash(ctx, req.(*BlockHeaderHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetBlockHeaderByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeaderNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetBlockHeaderByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetBlockHeaderByNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetBlockHeaderByNumber(ctx, req.(*BlockHeaderNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_ListBlockHeaderByRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHeaderByRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).ListBlockHeaderByRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_ListBlockHeaderByRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).ListBlockHeaderByRange(ctx, req.(*BlockHeaderByRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetAccount(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetBalances(ctx, req.(*BalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetTokenInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetTokenInfo(ctx, req.(*TokenInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_ResolveEnsName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnsNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).ResolveEnsName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_ResolveEnsName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).ResolveEnsName(ctx, req.(*EnsNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_LookupEnsName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnsAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).LookupEnsName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_LookupEnsName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).LookupEnsName(ctx, req.(*EnsAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetNonceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetNonceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetNonceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetNonceStatus(ctx, req.(*NonceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetFee(ctx, req.(*FeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_SendTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).SendTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_SendTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).SendTx(ctx, req.(*SendTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_SimulateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).SimulateTransaction(ctx, req.(*SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_ListTxByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).ListTxByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_ListTxByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).ListTxByAddress(ctx, req.(*TxAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetTxByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetTxByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetTxByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetTxByHash(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_CreateUnSignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnSignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).CreateUnSignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_CreateUnSignTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).CreateUnSignTransaction(ctx, req.(*UnSignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_CreateReplacementTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplacementTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).CreateReplacementTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_CreateReplacementTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).CreateReplacementTransaction(ctx, req.(*ReplacementTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_CreateUnSignAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnSignAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).CreateUnSignAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_CreateUnSignAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).CreateUnSignAuthorization(ctx, req.(*UnSignAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_BuildSignedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignedTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).BuildSignedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_BuildSignedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).BuildSignedTransaction(ctx, req.(*SignedTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_DecodeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).DecodeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_DecodeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).DecodeTransaction(ctx, req.(*DecodeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_VerifySignedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).VerifySignedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_VerifySignedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).VerifySignedTransaction(ctx, req.(*VerifyTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletAccountService_GetExtraData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtraDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletAccountServiceServer).GetExtraData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletAccountService_GetExtraData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletAccountServiceServer).GetExtraData(ctx, req.(*ExtraDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletAccountService_ServiceDesc is the grpc.ServiceDesc for WalletAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dapplink.account.WalletAccountService",
+	HandlerType: (*WalletAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSupportChains",
+			Handler:    _WalletAccountService_GetSupportChains_Handler,
+		},
+		{
+			MethodName: "GetChainCapabilities",
+			Handler:    _WalletAccountService_GetChainCapabilities_Handler,
+		},
+		{
+			MethodName: "ConvertAddress",
+			Handler:    _WalletAccountService_ConvertAddress_Handler,
+		},
+		{
+			MethodName: "ValidAddress",
+			Handler:    _WalletAccountService_ValidAddress_Handler,
+		},
+		{
+			MethodName: "GetBlockByNumber",
+			Handler:    _WalletAccountService_GetBlockByNumber_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _WalletAccountService_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderByHash",
+			Handler:    _WalletAccountService_GetBlockHeaderByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderByNumber",
+			Handler:    _WalletAccountService_GetBlockHeaderByNumber_Handler,
+		},
+		{
+			MethodName: "ListBlockHeaderByRange",
+			Handler:    _WalletAccountService_ListBlockHeaderByRange_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _WalletAccountService_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetBalances",
+			Handler:    _WalletAccountService_GetBalances_Handler,
+		},
+		{
+			MethodName: "GetTokenInfo",
+			Handler:    _WalletAccountService_GetTokenInfo_Handler,
+		},
+		{
+			MethodName: "ResolveEnsName",
+			Handler:    _WalletAccountService_ResolveEnsName_Handler,
+		},
+		{
+			MethodName: "LookupEnsName",
+			Handler:    _WalletAccountService_LookupEnsName_Handler,
+		},
+		{
+			MethodName: "GetNonceStatus",
+			Handler:    _WalletAccountService_GetNonceStatus_Handler,
+		},
+		{
+			MethodName: "GetFee",
+			Handler:    _WalletAccountService_GetFee_Handler,
+		},
+		{
+			MethodName: "SendTx",
+			Handler:    _WalletAccountService_SendTx_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _WalletAccountService_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "ListTxByAddress",
+			Handler:    _WalletAccountService_ListTxByAddress_Handler,
+		},
+		{
+			MethodName: "GetTxByHash",
+			Handler:    _WalletAccountService_GetTxByHash_Handler,
+		},
+		{
+			MethodName: "CreateUnSignTransaction",
+			Handler:    _WalletAccountService_CreateUnSignTransaction_Handler,
+		},
+		{
+			MethodName: "CreateReplacementTransaction",
+			Handler:    _WalletAccountService_CreateReplacementTransaction_Handler,
+		},
+		{
+			MethodName: "CreateUnSignAuthorization",
+			Handler:    _WalletAccountService_CreateUnSignAuthorization_Handler,
+		},
+		{
+			MethodName: "BuildSignedTransaction",
+			Handler:    _WalletAccountService_BuildSignedTransaction_Handler,
+		},
+		{
+			MethodName: "DecodeTransaction",
+			Handler:    _WalletAccountService_DecodeTransaction_Handler,
+		},
+		{
+			MethodName: "VerifySignedTransaction",
+			Handler:    _WalletAccountService_VerifySignedTransaction_Handler,
+		},
+		{
+			MethodName: "GetExtraData",
+			Handler:    _WalletAccountService_GetExtraData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "account.proto",
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/rpc/account"
+)
+
+// domain <-> protobuf 结构体转换
+
+func toPbChainCapability(capability domain.ChainCapability) *account.ChainCapability {
+	return &account.ChainCapability{
+		Chain:   capability.Chain,
+		Network: capability.Network,
+		Methods: capability.Methods,
+		TxKinds: capability.TxKinds,
+	}
+}
+
+func toPbBlock(block domain.Block) *account.Block {
+	txs := make([]*account.BlockTransaction, 0, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		if tx == nil {
+			continue
+		}
+		txs = append(txs, &account.BlockTransaction{
+			From:           tx.From,
+			To:             tx.To,
+			TokenAddress:   tx.TokenAddress,
+			ContractWallet: tx.ContractWallet,
+			Hash:           tx.Hash,
+			Height:         tx.Height,
+			Amount:         tx.Amount,
+			Decimals:       tx.Decimals,
+			Symbol:         tx.Symbol,
+		})
+	}
+	return &account.Block{
+		Height:       block.Height,
+		Hash:         block.Hash,
+		BaseFee:      block.BaseFee,
+		Transactions: txs,
+		BlobBaseFee:  block.BlobBaseFee,
+	}
+}
+
+func toPbBlockHeader(header domain.BlockHeader) *account.BlockHeader {
+	return &account.BlockHeader{
+		Hash:             header.Hash,
+		ParentHash:       header.ParentHash,
+		UncleHash:        header.UncleHash,
+		CoinBase:         header.CoinBase,
+		Root:             header.Root,
+		TxHash:           header.TxHash,
+		ReceiptHash:      header.ReceiptHash,
+		ParentBeaconRoot: header.ParentBeaconRoot,
+		Difficulty:       header.Difficulty,
+		Number:           header.Number,
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Time:             header.Time,
+		Extra:            header.Extra,
+		MixDigest:        header.MixDigest,
+		Nonce:            header.Nonce,
+		BaseFee:          header.BaseFee,
+		WithdrawalsHash:  header.WithdrawalsHash,
+		BlobGasUsed:      header.BlobGasUsed,
+		ExcessBlobGas:    header.ExcessBlobGas,
+		BlobBaseFee:      header.BlobBaseFee,
+	}
+}
+
+func toPbBalances(balances domain.Balances) *account.Balances {
+	items := make([]*account.TokenBalance, 0, len(balances.Balances))
+	for _, item := range balances.Balances {
+		items = append(items, &account.TokenBalance{
+			Address:         item.Address,
+			ContractAddress: item.ContractAddress,
+			Balance:         item.Balance,
+			Error:           item.Error,
+			Decimals:        item.Decimals,
+			Symbol:          item.Symbol,
+			Name:            item.Name,
+		})
+	}
+	return &account.Balances{
+		Height:   balances.Height,
+		Balances: items,
+	}
+}
+
+func toPbNonceStatus(status domain.NonceStatus) *account.NonceStatus {
+	inFlight := make([]*account.InFlightNonce, 0, len(status.InFlight))
+	for _, item := range status.InFlight {
+		inFlight = append(inFlight, &account.InFlightNonce{
+			Nonce:  item.Nonce,
+			TxHash: item.TxHash,
+			State:  item.State,
+			Age:    item.Age,
+		})
+	}
+	return &account.NonceStatus{
+		LatestNonce:  status.LatestNonce,
+		PendingNonce: status.PendingNonce,
+		NextNonce:    status.NextNonce,
+		InFlight:     inFlight,
+		Gaps:         status.Gaps,
+		Stuck:        status.Stuck,
+		Address:      status.Address,
+		Name:         status.Name,
+	}
+}
+
+func toPbUnSignTransaction(unSignTx domain.UnSignTransaction) *account.UnSignTransactionResponse {
+	resolved := make([]*account.ResolvedAddress, 0, len(unSignTx.ResolvedAddresses))
+	for _, item := range unSignTx.ResolvedAddresses {
+		resolved = append(resolved, &account.ResolvedAddress{
+			Field:   item.Field,
+			Name:    item.Name,
+			Address: item.Address,
+		})
+	}
+	return &account.UnSignTransactionResponse{
+		UnSignTx:          unSignTx.UnSignTx,
+		Base64Tx:          unSignTx.Base64Tx,
+		ResolvedAddresses: resolved,
+	}
+}
+
+func toPbSimulationResult(result domain.SimulationResult) *account.SimulationResult {
+	pbResult := &account.SimulationResult{
+		Success:    result.Success,
+		ReturnData: result.ReturnData,
+	}
+	if result.Revert != nil {
+		args := make([]*account.RevertArg, 0, len(result.Revert.Args))
+		for _, arg := range result.Revert.Args {
+			args = append(args, &account.RevertArg{
+				Name:  arg.Name,
+				Type:  arg.Type,
+				Value: arg.Value,
+			})
+		}
+		pbResult.Revert = &account.RevertReason{
+			Kind:      result.Revert.Kind,
+			Message:   result.Revert.Message,
+			Signature: result.Revert.Signature,
+			Selector:  result.Revert.Selector,
+			Args:      args,
+			Data:      result.Revert.Data,
+		}
+	}
+	return pbResult
+}
+
+func toPbGasFee(fee domain.GasFee) *account.GasFee {
+	return &account.GasFee{
+		GasPrice:  fee.GasPrice,
+		GasTipCap: fee.GasTipCap,
+		MultiVal:  fee.MultiVal,
+		GasLimit:  fee.GasLimit,
+		MaxCost:   fee.MaxCost,
+
+		MaxFeePerGas:         fee.MaxFeePerGas,
+		MaxPriorityFeePerGas: fee.MaxPriorityFeePerGas,
+	}
+}
+
+func toPbTxMessage(tx domain.TxMessage) *account.TxMessage {
+	return &account.TxMessage{
+		Hash:            tx.Hash,
+		Index:           tx.Index,
+		Froms:           tx.Froms,
+		Tos:             tx.Tos,
+		Fee:             tx.Fee,
+		Status:          account.TxStatus(tx.Status),
+		Values:          tx.Values,
+		Type:            tx.Type,
+		Height:          tx.Height,
+		ContractAddress: tx.ContractAddress,
+		Datetime:        tx.Datetime,
+		Data:            tx.Data,
+		TokenAddresses:  tx.TokenAddresses,
+		TokenIds:        tx.TokenIds,
+
+		BlobVersionedHashes: tx.BlobVersionedHashes,
+		MaxFeePerBlobGas:    tx.MaxFeePerBlobGas,
+		BlobGasUsed:         tx.BlobGasUsed,
+		BlobGasPrice:        tx.BlobGasPrice,
+		BlobFee:             tx.BlobFee,
+		Decimals:            tx.Decimals,
+		Symbols:             tx.Symbols,
+	}
+}
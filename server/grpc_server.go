@@ -0,0 +1,520 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/rpc/account"
+	"github.com/web3-fighter/wallet-chain-account/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"net"
+)
+
+const MaxRecvMessageSize = 1024 * 1024 * 30
+
+var _ account.WalletAccountServiceServer = (*GrpcServer)(nil)
+
+// GrpcServer 把 service.WalletAccountService 适配为 gRPC 接口
+type GrpcServer struct {
+	account.UnimplementedWalletAccountServiceServer
+	conf   config.Server
+	svc    service.WalletAccountService
+	server *grpc.Server
+}
+
+func NewGrpcServer(conf config.Server, svc service.WalletAccountService) *GrpcServer {
+	s := &GrpcServer{
+		conf: conf,
+		svc:  svc,
+		server: grpc.NewServer(
+			grpc.MaxRecvMsgSize(MaxRecvMessageSize),
+		),
+	}
+	account.RegisterWalletAccountServiceServer(s.server, s)
+	reflection.Register(s.server)
+	return s
+}
+
+// Start 监听 config.Server.Port 并在后台提供服务
+func (s *GrpcServer) Start(_ context.Context) error {
+	listener, err := net.Listen("tcp", ":"+s.conf.Port)
+	if err != nil {
+		log.Error("grpc server listen fail", "port", s.conf.Port, "err", err)
+		return fmt.Errorf("grpc server listen fail: %w", err)
+	}
+	log.Info("grpc server start", "addr", listener.Addr().String())
+	go func() {
+		if err := s.Serve(listener); err != nil {
+			log.Error("grpc server serve fail", "err", err)
+		}
+	}()
+	return nil
+}
+
+// Serve 在指定 listener 上提供服务（阻塞），测试时可传入 bufconn.Listener
+func (s *GrpcServer) Serve(listener net.Listener) error {
+	return s.server.Serve(listener)
+}
+
+func (s *GrpcServer) Stop(_ context.Context) error {
+	s.server.GracefulStop()
+	return nil
+}
+
+// grpcCodes errcode.Code -> gRPC codes
+var grpcCodes = map[errcode.Code]codes.Code{
+	errcode.NotFound:            codes.NotFound,
+	errcode.InvalidArgument:     codes.InvalidArgument,
+	errcode.Unsupported:         codes.Unimplemented,
+	errcode.UpstreamUnavailable: codes.Unavailable,
+	errcode.SignatureMismatch:   codes.InvalidArgument,
+	errcode.InsufficientFunds:   codes.FailedPrecondition,
+	errcode.Unauthenticated:     codes.Unauthenticated,
+	errcode.PermissionDenied:    codes.PermissionDenied,
+	errcode.Unimplemented:       codes.Unimplemented,
+}
+
+// toStatusError 将 service 层错误按 errcode 转换为 gRPC status
+func toStatusError(err error) error {
+	if code, ok := grpcCodes[errcode.CodeOf(err)]; ok {
+		return status.Error(code, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func (s *GrpcServer) GetSupportChains(ctx context.Context, req *account.SupportChainsRequest) (*account.SupportChainsResponse, error) {
+	support, err := s.svc.GetSupportChains(ctx, domain.SupportChainsParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.SupportChainsResponse{Support: support}, nil
+}
+
+func (s *GrpcServer) GetChainCapabilities(ctx context.Context, req *account.ChainCapabilitiesRequest) (*account.ChainCapabilitiesResponse, error) {
+	capabilities, err := s.svc.GetChainCapabilities(ctx, domain.ChainCapabilitiesParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	resp := &account.ChainCapabilitiesResponse{
+		Capabilities: make([]*account.ChainCapability, 0, len(capabilities)),
+	}
+	for _, item := range capabilities {
+		resp.Capabilities = append(resp.Capabilities, toPbChainCapability(item))
+	}
+	return resp, nil
+}
+
+func (s *GrpcServer) ConvertAddress(ctx context.Context, req *account.ConvertAddressRequest) (*account.ConvertAddressResponse, error) {
+	address, err := s.svc.ConvertAddress(ctx, domain.ConvertAddressParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Type:          req.Type,
+		PublicKey:     req.PublicKey,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.ConvertAddressResponse{Address: address}, nil
+}
+
+func (s *GrpcServer) ValidAddress(ctx context.Context, req *account.ValidAddressRequest) (*account.ValidAddressResponse, error) {
+	validation, err := s.svc.ValidAddress(ctx, domain.ValidAddressParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Address:       req.Address,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.ValidAddressResponse{
+		Valid:       validation.Valid,
+		Checksummed: validation.Checksummed,
+		Address:     validation.Address,
+		Reason:      validation.Reason,
+		Name:        validation.Name,
+	}, nil
+}
+
+func (s *GrpcServer) GetBlockByNumber(ctx context.Context, req *account.BlockNumberRequest) (*account.Block, error) {
+	block, err := s.svc.GetBlockByNumber(ctx, domain.BlockNumberParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Height:        req.Height,
+		ViewTx:        req.ViewTx,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbBlock(block), nil
+}
+
+func (s *GrpcServer) GetBlockByHash(ctx context.Context, req *account.BlockHashRequest) (*account.Block, error) {
+	block, err := s.svc.GetBlockByHash(ctx, domain.BlockHashParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Hash:          req.Hash,
+		ViewTx:        req.ViewTx,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbBlock(block), nil
+}
+
+func (s *GrpcServer) GetBlockHeaderByHash(ctx context.Context, req *account.BlockHeaderHashRequest) (*account.BlockHeader, error) {
+	header, err := s.svc.GetBlockHeaderByHash(ctx, domain.BlockHeaderHashParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Hash:          req.Hash,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbBlockHeader(header), nil
+}
+
+func (s *GrpcServer) GetBlockHeaderByNumber(ctx context.Context, req *account.BlockHeaderNumberRequest) (*account.BlockHeader, error) {
+	header, err := s.svc.GetBlockHeaderByNumber(ctx, domain.BlockHeaderNumberParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Height:        req.Height,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbBlockHeader(header), nil
+}
+
+func (s *GrpcServer) ListBlockHeaderByRange(ctx context.Context, req *account.BlockHeaderByRangeRequest) (*account.BlockHeaderByRangeResponse, error) {
+	headers, err := s.svc.ListBlockHeaderByRange(ctx, domain.BlockHeaderByRangeParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Start:         req.Start,
+		End:           req.End,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	pbHeaders := make([]*account.BlockHeader, 0, len(headers))
+	for _, header := range headers {
+		pbHeaders = append(pbHeaders, toPbBlockHeader(header))
+	}
+	return &account.BlockHeaderByRangeResponse{BlockHeaders: pbHeaders}, nil
+}
+
+func (s *GrpcServer) GetAccount(ctx context.Context, req *account.AccountRequest) (*account.Account, error) {
+	acc, err := s.svc.GetAccount(ctx, domain.AccountParam{
+		ConsumerToken:    req.ConsumerToken,
+		Chain:            req.Chain,
+		Coin:             req.Coin,
+		Network:          req.Network,
+		Address:          req.Address,
+		ContractAddress:  req.ContractAddress,
+		ProposerKeyIndex: req.ProposerKeyIndex,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.Account{
+		Network:       acc.Network,
+		AccountNumber: acc.AccountNumber,
+		Sequence:      acc.Sequence,
+		Balance:       acc.Balance,
+		AccountType:   acc.AccountType,
+		Delegate:      acc.Delegate,
+		Decimals:      acc.Decimals,
+		Symbol:        acc.Symbol,
+		Address:       acc.Address,
+		Name:          acc.Name,
+	}, nil
+}
+
+func (s *GrpcServer) GetBalances(ctx context.Context, req *account.BalancesRequest) (*account.Balances, error) {
+	queries := make([]domain.BalanceQuery, 0, len(req.Queries))
+	for _, query := range req.Queries {
+		queries = append(queries, domain.BalanceQuery{
+			Address:         query.Address,
+			ContractAddress: query.ContractAddress,
+		})
+	}
+	balances, err := s.svc.GetBalances(ctx, domain.BalancesParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Queries:       queries,
+		Height:        req.Height,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbBalances(balances), nil
+}
+
+func (s *GrpcServer) GetTokenInfo(ctx context.Context, req *account.TokenInfoRequest) (*account.TokenInfo, error) {
+	info, err := s.svc.GetTokenInfo(ctx, domain.TokenInfoParam{
+		ConsumerToken:   req.ConsumerToken,
+		Chain:           req.Chain,
+		Network:         req.Network,
+		ContractAddress: req.ContractAddress,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.TokenInfo{
+		ContractAddress: info.ContractAddress,
+		Name:            info.Name,
+		Symbol:          info.Symbol,
+		Decimals:        info.Decimals,
+	}, nil
+}
+
+func (s *GrpcServer) ResolveEnsName(ctx context.Context, req *account.EnsNameRequest) (*account.EnsRecord, error) {
+	record, err := s.svc.ResolveEnsName(ctx, domain.EnsNameParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Name:          req.Name,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.EnsRecord{Name: record.Name, Address: record.Address}, nil
+}
+
+func (s *GrpcServer) LookupEnsName(ctx context.Context, req *account.EnsAddressRequest) (*account.EnsRecord, error) {
+	record, err := s.svc.LookupEnsName(ctx, domain.EnsAddressParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Address:       req.Address,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.EnsRecord{Name: record.Name, Address: record.Address}, nil
+}
+
+func (s *GrpcServer) GetNonceStatus(ctx context.Context, req *account.NonceStatusRequest) (*account.NonceStatus, error) {
+	status, err := s.svc.GetNonceStatus(ctx, domain.NonceStatusParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Address:       req.Address,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbNonceStatus(status), nil
+}
+
+func (s *GrpcServer) GetFee(ctx context.Context, req *account.FeeRequest) (*account.Fee, error) {
+	fee, err := s.svc.GetFee(ctx, domain.FeeParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Coin:          req.Coin,
+		Network:       req.Network,
+		RawTx:         req.RawTx,
+		Address:       req.Address,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.Fee{
+		SlowFee:   toPbGasFee(fee.SlowFee),
+		NormalFee: toPbGasFee(fee.NormalFee),
+		FastFee:   toPbGasFee(fee.FastFee),
+	}, nil
+}
+
+func (s *GrpcServer) SendTx(ctx context.Context, req *account.SendTxRequest) (*account.SendTxResponse, error) {
+	txHash, err := s.svc.SendTx(ctx, domain.SendTxParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Coin:          req.Coin,
+		Network:       req.Network,
+		RawTx:         req.RawTx,
+		Simulate:      req.Simulate,
+		Abi:           req.Abi,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.SendTxResponse{TxHash: txHash}, nil
+}
+
+func (s *GrpcServer) SimulateTransaction(ctx context.Context, req *account.SimulateTransactionRequest) (*account.SimulationResult, error) {
+	result, err := s.svc.SimulateTransaction(ctx, domain.SimulateTransactionParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		RawTx:         req.RawTx,
+		Abi:           req.Abi,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbSimulationResult(result), nil
+}
+
+func (s *GrpcServer) ListTxByAddress(ctx context.Context, req *account.TxAddressRequest) (*account.TxAddressResponse, error) {
+	txs, err := s.svc.ListTxByAddress(ctx, domain.TxAddressParam{
+		ConsumerToken:   req.ConsumerToken,
+		Chain:           req.Chain,
+		Coin:            req.Coin,
+		Network:         req.Network,
+		Address:         req.Address,
+		ContractAddress: req.ContractAddress,
+		Page:            req.Page,
+		PageSize:        req.Pagesize,
+		Cursor:          req.Cursor,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	pbTxs := make([]*account.TxMessage, 0, len(txs))
+	for _, tx := range txs {
+		pbTxs = append(pbTxs, toPbTxMessage(tx))
+	}
+	return &account.TxAddressResponse{Tx: pbTxs}, nil
+}
+
+func (s *GrpcServer) GetTxByHash(ctx context.Context, req *account.TxHashRequest) (*account.TxMessage, error) {
+	tx, err := s.svc.GetTxByHash(ctx, domain.GetTxByHashParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Coin:          req.Coin,
+		Network:       req.Network,
+		Hash:          req.Hash,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbTxMessage(tx), nil
+}
+
+func (s *GrpcServer) CreateUnSignTransaction(ctx context.Context, req *account.UnSignTransactionRequest) (*account.UnSignTransactionResponse, error) {
+	unSignTx, err := s.svc.CreateUnSignTransaction(ctx, domain.UnSignTransactionParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Base64Tx:      req.Base64Tx,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toPbUnSignTransaction(unSignTx), nil
+}
+
+func (s *GrpcServer) CreateReplacementTransaction(ctx context.Context, req *account.ReplacementTransactionRequest) (*account.ReplacementTransactionResponse, error) {
+	replacement, err := s.svc.CreateReplacementTransaction(ctx, domain.ReplacementTransactionParam{
+		ConsumerToken:        req.ConsumerToken,
+		Chain:                req.Chain,
+		Network:              req.Network,
+		TxHash:               req.TxHash,
+		Mode:                 req.Mode,
+		MaxFeePerGas:         req.MaxFeePerGas,
+		MaxPriorityFeePerGas: req.MaxPriorityFeePerGas,
+		GasPrice:             req.GasPrice,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.ReplacementTransactionResponse{
+		Base64Tx: replacement.Base64Tx,
+		UnSignTx: replacement.UnSignTx,
+		Nonce:    replacement.Nonce,
+	}, nil
+}
+
+func (s *GrpcServer) CreateUnSignAuthorization(ctx context.Context, req *account.UnSignAuthorizationRequest) (*account.UnSignAuthorizationResponse, error) {
+	unSignAuthorization, err := s.svc.CreateUnSignAuthorization(ctx, domain.UnSignAuthorizationParam{
+		ConsumerToken:       req.ConsumerToken,
+		Chain:               req.Chain,
+		Network:             req.Network,
+		Base64Authorization: req.Base64Authorization,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.UnSignAuthorizationResponse{UnSignAuthorization: unSignAuthorization}, nil
+}
+
+func (s *GrpcServer) BuildSignedTransaction(ctx context.Context, req *account.SignedTransactionRequest) (*account.SignedTransaction, error) {
+	signedTx, err := s.svc.BuildSignedTransaction(ctx, domain.SignedTransactionParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Base64Tx:      req.Base64Tx,
+		Signature:     req.Signature,
+		PublicKey:     req.PublicKey,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.SignedTransaction{
+		TxHash:   signedTx.TxHash,
+		SignedTx: signedTx.SignedTx,
+	}, nil
+}
+
+func (s *GrpcServer) DecodeTransaction(ctx context.Context, req *account.DecodeTransactionRequest) (*account.DecodeTransactionResponse, error) {
+	base64Tx, err := s.svc.DecodeTransaction(ctx, domain.DecodeTransactionParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		RawTx:         req.RawTx,
+		Abi:           req.Abi,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.DecodeTransactionResponse{Base64Tx: base64Tx}, nil
+}
+
+func (s *GrpcServer) VerifySignedTransaction(ctx context.Context, req *account.VerifyTransactionRequest) (*account.VerifyTransactionResponse, error) {
+	verify, err := s.svc.VerifySignedTransaction(ctx, domain.VerifyTransactionParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		PublicKey:     req.PublicKey,
+		Signature:     req.Signature,
+		RawTx:         req.RawTx,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.VerifyTransactionResponse{Verify: verify}, nil
+}
+
+func (s *GrpcServer) GetExtraData(ctx context.Context, req *account.ExtraDataRequest) (*account.ExtraDataResponse, error) {
+	value, err := s.svc.GetExtraData(ctx, domain.ExtraDataParam{
+		ConsumerToken: req.ConsumerToken,
+		Chain:         req.Chain,
+		Network:       req.Network,
+		Address:       req.Address,
+		Coin:          req.Coin,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &account.ExtraDataResponse{Value: value}, nil
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/rpc/account"
+	"github.com/web3-fighter/wallet-chain-account/service/unimplemente"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+// fakeService GetAccount 按地址返回固定结果或错误，其余方法为 Unimplemented
+type fakeService struct {
+	unimplemente.UnimplementedService
+}
+
+func (s *fakeService) GetAccount(_ context.Context, param domain.AccountParam) (domain.Account, error) {
+	switch param.Address {
+	case "missing":
+		return domain.Account{}, errcode.New(errcode.NotFound, "account %s not found", param.Address)
+	case "bad":
+		return domain.Account{}, errcode.New(errcode.InvalidArgument, "invalid address: %s", param.Address)
+	}
+	return domain.Account{Network: param.Network, Sequence: "7", Balance: "100", Address: param.Address}, nil
+}
+
+func newBufconnClient(t *testing.T) account.WalletAccountServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	s := NewGrpcServer(config.Server{}, &fakeService{})
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = s.Stop(context.Background())
+	})
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return account.NewWalletAccountServiceClient(conn)
+}
+
+func TestGrpcServerGetAccount(t *testing.T) {
+	client := newBufconnClient(t)
+	resp, err := client.GetAccount(context.Background(), &account.AccountRequest{
+		Chain:   "Ethereum",
+		Network: "mainnet",
+		Address: "0x0000000000000000000000000000000000000001",
+	})
+	if err != nil {
+		t.Fatalf("GetAccount: %v", err)
+	}
+	if resp.Network != "mainnet" || resp.Sequence != "7" || resp.Balance != "100" || resp.Address != "0x0000000000000000000000000000000000000001" {
+		t.Fatalf("unexpected account: %+v", resp)
+	}
+}
+
+func TestGrpcServerStatusCodes(t *testing.T) {
+	client := newBufconnClient(t)
+	tests := []struct {
+		name    string
+		address string
+		want    codes.Code
+	}{
+		{name: "not found", address: "missing", want: codes.NotFound},
+		{name: "invalid argument", address: "bad", want: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetAccount(context.Background(), &account.AccountRequest{Address: tt.address})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("status code = %s, want %s (err: %v)", got, tt.want, err)
+			}
+		})
+	}
+
+	_, err := client.GetFee(context.Background(), &account.FeeRequest{})
+	if got := status.Code(err); got != codes.Unimplemented {
+		t.Fatalf("unimplemented method status code = %s, want %s", got, codes.Unimplemented)
+	}
+}
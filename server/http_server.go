@@ -0,0 +1,511 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	ConsumerTokenHeader = "X-Consumer-Token"
+
+	defaultReadTimeout  = 30 * time.Second
+	defaultWriteTimeout = 60 * time.Second
+)
+
+// HttpServer 把 service.WalletAccountService 以 REST + JSON 的方式暴露出来，
+// 请求体 / 响应体直接复用 domain 中的结构体及其 json tag。
+type HttpServer struct {
+	conf   config.Server
+	svc    service.WalletAccountService
+	server *http.Server
+}
+
+// errorResponse 出错时返回的 JSON 结构，code 为 errcode 分类名
+type errorResponse struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+func NewHttpServer(conf config.Server, svc service.WalletAccountService) *HttpServer {
+	s := &HttpServer{conf: conf, svc: svc}
+	s.server = &http.Server{
+		Handler:      s.Handler(),
+		ReadTimeout:  defaultReadTimeout,
+		WriteTimeout: defaultWriteTimeout,
+	}
+	return s
+}
+
+// Handler 返回注册好全部路由的 http.Handler
+func (s *HttpServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/capabilities", s.getChainCapabilities)
+	mux.HandleFunc("GET /v1/{chain}/support", s.getSupportChains)
+	mux.HandleFunc("GET /v1/{chain}/capabilities", s.getChainCapabilities)
+	mux.HandleFunc("POST /v1/{chain}/address/convert", s.convertAddress)
+	mux.HandleFunc("GET /v1/{chain}/address/{address}/valid", s.validAddress)
+	mux.HandleFunc("GET /v1/{chain}/address/{address}/account", s.getAccount)
+	mux.HandleFunc("GET /v1/{chain}/address/{address}/nonce", s.getNonceStatus)
+	mux.HandleFunc("POST /v1/{chain}/balances", s.getBalances)
+	mux.HandleFunc("GET /v1/{chain}/token/{address}", s.getTokenInfo)
+	mux.HandleFunc("GET /v1/{chain}/ens/{name}", s.resolveEnsName)
+	mux.HandleFunc("GET /v1/{chain}/address/{address}/ens", s.lookupEnsName)
+	mux.HandleFunc("GET /v1/{chain}/address/{address}/txs", s.listTxByAddress)
+	mux.HandleFunc("GET /v1/{chain}/address/{address}/extra", s.getExtraData)
+	mux.HandleFunc("GET /v1/{chain}/block/{height}", s.getBlockByNumber)
+	mux.HandleFunc("GET /v1/{chain}/block/hash/{hash}", s.getBlockByHash)
+	mux.HandleFunc("GET /v1/{chain}/header/{height}", s.getBlockHeaderByNumber)
+	mux.HandleFunc("GET /v1/{chain}/header/hash/{hash}", s.getBlockHeaderByHash)
+	mux.HandleFunc("GET /v1/{chain}/headers", s.listBlockHeaderByRange)
+	mux.HandleFunc("POST /v1/{chain}/fee", s.getFee)
+	mux.HandleFunc("GET /v1/{chain}/tx/{hash}", s.getTxByHash)
+	mux.HandleFunc("POST /v1/{chain}/tx/send", s.sendTx)
+	mux.HandleFunc("POST /v1/{chain}/tx/simulate", s.simulateTransaction)
+	mux.HandleFunc("POST /v1/{chain}/tx/unsigned", s.createUnSignTransaction)
+	mux.HandleFunc("POST /v1/{chain}/tx/replacement", s.createReplacementTransaction)
+	mux.HandleFunc("POST /v1/{chain}/tx/signed", s.buildSignedTransaction)
+	mux.HandleFunc("POST /v1/{chain}/authorization/unsigned", s.createUnSignAuthorization)
+	mux.HandleFunc("POST /v1/{chain}/tx/decode", s.decodeTransaction)
+	mux.HandleFunc("POST /v1/{chain}/tx/verify", s.verifySignedTransaction)
+	return mux
+}
+
+// Start 监听 config.Server.HttpPort 并在后台提供服务
+func (s *HttpServer) Start(_ context.Context) error {
+	listener, err := net.Listen("tcp", ":"+s.conf.HttpPort)
+	if err != nil {
+		log.Error("http server listen fail", "port", s.conf.HttpPort, "err", err)
+		return fmt.Errorf("http server listen fail: %w", err)
+	}
+	log.Info("http server start", "addr", listener.Addr().String())
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("http server serve fail", "err", err)
+		}
+	}()
+	return nil
+}
+
+func (s *HttpServer) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+// httpStatusCodes errcode.Code -> HTTP 状态码
+var httpStatusCodes = map[errcode.Code]int{
+	errcode.NotFound:            http.StatusNotFound,
+	errcode.InvalidArgument:     http.StatusBadRequest,
+	errcode.Unsupported:         http.StatusNotImplemented,
+	errcode.UpstreamUnavailable: http.StatusBadGateway,
+	errcode.SignatureMismatch:   http.StatusBadRequest,
+	errcode.InsufficientFunds:   http.StatusUnprocessableEntity,
+	errcode.Unauthenticated:     http.StatusUnauthorized,
+	errcode.PermissionDenied:    http.StatusForbidden,
+	errcode.Unimplemented:       http.StatusNotImplemented,
+}
+
+// httpStatus 将 service 层错误按 errcode 映射为 HTTP 状态码
+func httpStatus(err error) int {
+	if code, ok := httpStatusCodes[errcode.CodeOf(err)]; ok {
+		return code
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error("write http response fail", "err", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, errorResponse{Code: errcode.CodeOf(err).String(), Error: err.Error()})
+}
+
+// writeResult 根据 err 输出错误或正常结果
+func writeResult(w http.ResponseWriter, v any, err error) {
+	if err != nil {
+		writeError(w, httpStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+// decodeBody 解析 JSON 请求体，失败时直接返回 400
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, errcode.Wrap(errcode.InvalidArgument, err, "invalid request body"))
+		return false
+	}
+	return true
+}
+
+// queryInt 读取整数类型的 path / query 参数，失败时直接返回 400
+func queryInt(w http.ResponseWriter, name, value string) (int64, bool) {
+	if value == "" {
+		return 0, true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errcode.New(errcode.InvalidArgument, "invalid %s: %s", name, value))
+		return 0, false
+	}
+	return n, true
+}
+
+func consumerToken(r *http.Request) string {
+	if token := r.Header.Get(ConsumerTokenHeader); token != "" {
+		return token
+	}
+	return r.URL.Query().Get("consumer_token")
+}
+
+func (s *HttpServer) getSupportChains(w http.ResponseWriter, r *http.Request) {
+	support, err := s.svc.GetSupportChains(r.Context(), domain.SupportChainsParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+	})
+	writeResult(w, map[string]bool{"support": support}, err)
+}
+
+// getChainCapabilities 同时服务 /v1/capabilities（全部链）与 /v1/{chain}/capabilities
+func (s *HttpServer) getChainCapabilities(w http.ResponseWriter, r *http.Request) {
+	capabilities, err := s.svc.GetChainCapabilities(r.Context(), domain.ChainCapabilitiesParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+	})
+	writeResult(w, map[string][]domain.ChainCapability{"capabilities": capabilities}, err)
+}
+
+func (s *HttpServer) convertAddress(w http.ResponseWriter, r *http.Request) {
+	var param domain.ConvertAddressParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	address, err := s.svc.ConvertAddress(r.Context(), param)
+	writeResult(w, map[string]string{"address": address}, err)
+}
+
+func (s *HttpServer) validAddress(w http.ResponseWriter, r *http.Request) {
+	validation, err := s.svc.ValidAddress(r.Context(), domain.ValidAddressParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+		Address:       r.PathValue("address"),
+	})
+	writeResult(w, validation, err)
+}
+
+func (s *HttpServer) getAccount(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	proposerKeyIndex, ok := queryInt(w, "proposer_key_index", query.Get("proposer_key_index"))
+	if !ok {
+		return
+	}
+	acc, err := s.svc.GetAccount(r.Context(), domain.AccountParam{
+		ConsumerToken:    consumerToken(r),
+		Chain:            r.PathValue("chain"),
+		Coin:             query.Get("coin"),
+		Network:          query.Get("network"),
+		Address:          r.PathValue("address"),
+		ContractAddress:  query.Get("contract_address"),
+		ProposerKeyIndex: uint64(proposerKeyIndex),
+	})
+	writeResult(w, acc, err)
+}
+
+func (s *HttpServer) getBalances(w http.ResponseWriter, r *http.Request) {
+	var param domain.BalancesParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	balances, err := s.svc.GetBalances(r.Context(), param)
+	writeResult(w, balances, err)
+}
+
+func (s *HttpServer) getTokenInfo(w http.ResponseWriter, r *http.Request) {
+	info, err := s.svc.GetTokenInfo(r.Context(), domain.TokenInfoParam{
+		ConsumerToken:   consumerToken(r),
+		Chain:           r.PathValue("chain"),
+		Network:         r.URL.Query().Get("network"),
+		ContractAddress: r.PathValue("address"),
+	})
+	writeResult(w, info, err)
+}
+
+func (s *HttpServer) resolveEnsName(w http.ResponseWriter, r *http.Request) {
+	record, err := s.svc.ResolveEnsName(r.Context(), domain.EnsNameParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+		Name:          r.PathValue("name"),
+	})
+	writeResult(w, record, err)
+}
+
+func (s *HttpServer) lookupEnsName(w http.ResponseWriter, r *http.Request) {
+	record, err := s.svc.LookupEnsName(r.Context(), domain.EnsAddressParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+		Address:       r.PathValue("address"),
+	})
+	writeResult(w, record, err)
+}
+
+func (s *HttpServer) getNonceStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := s.svc.GetNonceStatus(r.Context(), domain.NonceStatusParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+		Address:       r.PathValue("address"),
+	})
+	writeResult(w, status, err)
+}
+
+func (s *HttpServer) listTxByAddress(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, ok := queryInt(w, "page", query.Get("page"))
+	if !ok {
+		return
+	}
+	pageSize, ok := queryInt(w, "pagesize", query.Get("pagesize"))
+	if !ok {
+		return
+	}
+	txs, err := s.svc.ListTxByAddress(r.Context(), domain.TxAddressParam{
+		ConsumerToken:   consumerToken(r),
+		Chain:           r.PathValue("chain"),
+		Coin:            query.Get("coin"),
+		Network:         query.Get("network"),
+		Address:         r.PathValue("address"),
+		ContractAddress: query.Get("contract_address"),
+		Page:            uint32(page),
+		PageSize:        uint32(pageSize),
+		Cursor:          query.Get("cursor"),
+	})
+	writeResult(w, map[string][]domain.TxMessage{"tx": txs}, err)
+}
+
+func (s *HttpServer) getExtraData(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	value, err := s.svc.GetExtraData(r.Context(), domain.ExtraDataParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       query.Get("network"),
+		Address:       r.PathValue("address"),
+		Coin:          query.Get("coin"),
+	})
+	writeResult(w, map[string]string{"value": value}, err)
+}
+
+func (s *HttpServer) getBlockByNumber(w http.ResponseWriter, r *http.Request) {
+	height, ok := queryInt(w, "height", r.PathValue("height"))
+	if !ok {
+		return
+	}
+	block, err := s.svc.GetBlockByNumber(r.Context(), domain.BlockNumberParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Height:        height,
+		ViewTx:        r.URL.Query().Get("view_tx") == "true",
+	})
+	writeResult(w, block, err)
+}
+
+func (s *HttpServer) getBlockByHash(w http.ResponseWriter, r *http.Request) {
+	block, err := s.svc.GetBlockByHash(r.Context(), domain.BlockHashParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Hash:          r.PathValue("hash"),
+		ViewTx:        r.URL.Query().Get("view_tx") == "true",
+	})
+	writeResult(w, block, err)
+}
+
+func (s *HttpServer) getBlockHeaderByNumber(w http.ResponseWriter, r *http.Request) {
+	height, ok := queryInt(w, "height", r.PathValue("height"))
+	if !ok {
+		return
+	}
+	header, err := s.svc.GetBlockHeaderByNumber(r.Context(), domain.BlockHeaderNumberParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+		Height:        height,
+	})
+	writeResult(w, header, err)
+}
+
+func (s *HttpServer) getBlockHeaderByHash(w http.ResponseWriter, r *http.Request) {
+	header, err := s.svc.GetBlockHeaderByHash(r.Context(), domain.BlockHeaderHashParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       r.URL.Query().Get("network"),
+		Hash:          r.PathValue("hash"),
+	})
+	writeResult(w, header, err)
+}
+
+func (s *HttpServer) listBlockHeaderByRange(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	headers, err := s.svc.ListBlockHeaderByRange(r.Context(), domain.BlockHeaderByRangeParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Network:       query.Get("network"),
+		Start:         query.Get("start"),
+		End:           query.Get("end"),
+	})
+	writeResult(w, map[string][]domain.BlockHeader{"block_headers": headers}, err)
+}
+
+func (s *HttpServer) getFee(w http.ResponseWriter, r *http.Request) {
+	var param domain.FeeParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	fee, err := s.svc.GetFee(r.Context(), param)
+	writeResult(w, fee, err)
+}
+
+func (s *HttpServer) getTxByHash(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	tx, err := s.svc.GetTxByHash(r.Context(), domain.GetTxByHashParam{
+		ConsumerToken: consumerToken(r),
+		Chain:         r.PathValue("chain"),
+		Coin:          query.Get("coin"),
+		Network:       query.Get("network"),
+		Hash:          r.PathValue("hash"),
+	})
+	writeResult(w, tx, err)
+}
+
+func (s *HttpServer) sendTx(w http.ResponseWriter, r *http.Request) {
+	var param domain.SendTxParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	txHash, err := s.svc.SendTx(r.Context(), param)
+	writeResult(w, map[string]string{"tx_hash": txHash}, err)
+}
+
+func (s *HttpServer) simulateTransaction(w http.ResponseWriter, r *http.Request) {
+	var param domain.SimulateTransactionParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	result, err := s.svc.SimulateTransaction(r.Context(), param)
+	writeResult(w, result, err)
+}
+
+func (s *HttpServer) createUnSignTransaction(w http.ResponseWriter, r *http.Request) {
+	var param domain.UnSignTransactionParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	unSignTx, err := s.svc.CreateUnSignTransaction(r.Context(), param)
+	writeResult(w, unSignTx, err)
+}
+
+func (s *HttpServer) createReplacementTransaction(w http.ResponseWriter, r *http.Request) {
+	var param domain.ReplacementTransactionParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	replacement, err := s.svc.CreateReplacementTransaction(r.Context(), param)
+	writeResult(w, replacement, err)
+}
+
+func (s *HttpServer) createUnSignAuthorization(w http.ResponseWriter, r *http.Request) {
+	var param domain.UnSignAuthorizationParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	unSignAuthorization, err := s.svc.CreateUnSignAuthorization(r.Context(), param)
+	writeResult(w, map[string]string{"un_sign_authorization": unSignAuthorization}, err)
+}
+
+func (s *HttpServer) buildSignedTransaction(w http.ResponseWriter, r *http.Request) {
+	var param domain.SignedTransactionParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	signedTx, err := s.svc.BuildSignedTransaction(r.Context(), param)
+	writeResult(w, signedTx, err)
+}
+
+func (s *HttpServer) decodeTransaction(w http.ResponseWriter, r *http.Request) {
+	var param domain.DecodeTransactionParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	base64Tx, err := s.svc.DecodeTransaction(r.Context(), param)
+	writeResult(w, map[string]string{"base64_tx": base64Tx}, err)
+}
+
+func (s *HttpServer) verifySignedTransaction(w http.ResponseWriter, r *http.Request) {
+	var param domain.VerifyTransactionParam
+	if !decodeBody(w, r, &param) {
+		return
+	}
+	param.Chain = r.PathValue("chain")
+	if param.ConsumerToken == "" {
+		param.ConsumerToken = consumerToken(r)
+	}
+	verify, err := s.svc.VerifySignedTransaction(r.Context(), param)
+	writeResult(w, map[string]bool{"verify": verify}, err)
+}
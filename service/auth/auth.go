@@ -0,0 +1,316 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service"
+)
+
+// MethodClass 方法类别，consumer 按类别授权
+type MethodClass string
+
+const (
+	// MethodClassRead 只读查询：区块、交易、账户、手续费、地址校验、交易解码/验签/预执行等
+	MethodClassRead MethodClass = "read"
+	// MethodClassSign 构造交易：CreateUnSignTransaction / CreateReplacementTransaction / CreateUnSignAuthorization / BuildSignedTransaction
+	MethodClassSign MethodClass = "sign"
+	// MethodClassBroadcast 广播交易：SendTx
+	MethodClassBroadcast MethodClass = "broadcast"
+
+	AllChains = "*"
+)
+
+var (
+	// ErrUnknownConsumer token 为空或未在配置中登记
+	ErrUnknownConsumer = errcode.New(errcode.Unauthenticated, "unknown consumer token")
+	// ErrConsumerForbidden token 合法，但无权访问该链或该类方法
+	ErrConsumerForbidden = errcode.New(errcode.PermissionDenied, "consumer not authorized")
+)
+
+var _ service.WalletAccountService = (*AuthService)(nil)
+
+type consumer struct {
+	name   string
+	chains map[string]struct{}
+	access map[MethodClass]struct{}
+}
+
+func (c *consumer) allowChain(chain string) bool {
+	if _, ok := c.chains[AllChains]; ok {
+		return true
+	}
+	_, ok := c.chains[chain]
+	return ok
+}
+
+func (c *consumer) allowClass(class MethodClass) bool {
+	_, ok := c.access[class]
+	return ok
+}
+
+// AuthService 包装 service.WalletAccountService，在转发前校验 ConsumerToken，
+// 校验失败时直接返回，不会触发任何上游 RPC 调用。
+type AuthService struct {
+	next      service.WalletAccountService
+	consumers map[string]*consumer
+}
+
+func NewAuthService(consumers []config.Consumer, next service.WalletAccountService) service.WalletAccountService {
+	s := &AuthService{
+		next:      next,
+		consumers: make(map[string]*consumer, len(consumers)),
+	}
+	for _, item := range consumers {
+		if item.Token == "" {
+			log.Warn("consumer token is empty, skip", "name", item.Name)
+			continue
+		}
+		c := &consumer{
+			name:   item.Name,
+			chains: make(map[string]struct{}, len(item.Chains)),
+			access: make(map[MethodClass]struct{}, len(item.Access)),
+		}
+		for _, chain := range item.Chains {
+			c.chains[chain] = struct{}{}
+		}
+		for _, class := range item.Access {
+			c.access[MethodClass(class)] = struct{}{}
+		}
+		s.consumers[item.Token] = c
+	}
+	return s
+}
+
+// authorize 校验 token 是否存在，以及是否有权以 class 类别访问 chain
+func (s *AuthService) authorize(token, chain string, class MethodClass) error {
+	c, ok := s.consumers[token]
+	if !ok {
+		log.Warn("reject unknown consumer", "chain", chain, "class", class)
+		return ErrUnknownConsumer
+	}
+	if !c.allowChain(chain) {
+		log.Warn("reject consumer chain", "consumer", c.name, "chain", chain)
+		return fmt.Errorf("%w: %s can not access chain %s", ErrConsumerForbidden, c.name, chain)
+	}
+	if !c.allowClass(class) {
+		log.Warn("reject consumer method", "consumer", c.name, "class", class)
+		return fmt.Errorf("%w: %s can not call %s methods", ErrConsumerForbidden, c.name, class)
+	}
+	return nil
+}
+
+func (s *AuthService) GetSupportChains(ctx context.Context, param domain.SupportChainsParam) (bool, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return false, err
+	}
+	return s.next.GetSupportChains(ctx, param)
+}
+
+// GetChainCapabilities Chain 为空时只校验 token 和 read 权限，并按 consumer 可访问的链过滤结果
+func (s *AuthService) GetChainCapabilities(ctx context.Context, param domain.ChainCapabilitiesParam) ([]domain.ChainCapability, error) {
+	if param.Chain != "" {
+		if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+			return nil, err
+		}
+		return s.next.GetChainCapabilities(ctx, param)
+	}
+	c, ok := s.consumers[param.ConsumerToken]
+	if !ok {
+		log.Warn("reject unknown consumer", "class", MethodClassRead)
+		return nil, ErrUnknownConsumer
+	}
+	if !c.allowClass(MethodClassRead) {
+		log.Warn("reject consumer method", "consumer", c.name, "class", MethodClassRead)
+		return nil, fmt.Errorf("%w: %s can not call %s methods", ErrConsumerForbidden, c.name, MethodClassRead)
+	}
+	capabilities, err := s.next.GetChainCapabilities(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make([]domain.ChainCapability, 0, len(capabilities))
+	for _, item := range capabilities {
+		if c.allowChain(item.Chain) {
+			allowed = append(allowed, item)
+		}
+	}
+	return allowed, nil
+}
+
+func (s *AuthService) ConvertAddress(ctx context.Context, param domain.ConvertAddressParam) (string, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return "", err
+	}
+	return s.next.ConvertAddress(ctx, param)
+}
+
+func (s *AuthService) ValidAddress(ctx context.Context, param domain.ValidAddressParam) (domain.AddressValidation, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.AddressValidation{}, err
+	}
+	return s.next.ValidAddress(ctx, param)
+}
+
+func (s *AuthService) GetBlockByNumber(ctx context.Context, param domain.BlockNumberParam) (domain.Block, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.Block{}, err
+	}
+	return s.next.GetBlockByNumber(ctx, param)
+}
+
+func (s *AuthService) GetBlockByHash(ctx context.Context, param domain.BlockHashParam) (domain.Block, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.Block{}, err
+	}
+	return s.next.GetBlockByHash(ctx, param)
+}
+
+func (s *AuthService) GetBlockHeaderByHash(ctx context.Context, param domain.BlockHeaderHashParam) (domain.BlockHeader, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.BlockHeader{}, err
+	}
+	return s.next.GetBlockHeaderByHash(ctx, param)
+}
+
+func (s *AuthService) GetBlockHeaderByNumber(ctx context.Context, param domain.BlockHeaderNumberParam) (domain.BlockHeader, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.BlockHeader{}, err
+	}
+	return s.next.GetBlockHeaderByNumber(ctx, param)
+}
+
+func (s *AuthService) ListBlockHeaderByRange(ctx context.Context, param domain.BlockHeaderByRangeParam) ([]domain.BlockHeader, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return nil, err
+	}
+	return s.next.ListBlockHeaderByRange(ctx, param)
+}
+
+func (s *AuthService) GetAccount(ctx context.Context, param domain.AccountParam) (domain.Account, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.Account{}, err
+	}
+	return s.next.GetAccount(ctx, param)
+}
+
+func (s *AuthService) GetBalances(ctx context.Context, param domain.BalancesParam) (domain.Balances, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.Balances{}, err
+	}
+	return s.next.GetBalances(ctx, param)
+}
+
+func (s *AuthService) GetTokenInfo(ctx context.Context, param domain.TokenInfoParam) (domain.TokenInfo, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.TokenInfo{}, err
+	}
+	return s.next.GetTokenInfo(ctx, param)
+}
+
+func (s *AuthService) ResolveEnsName(ctx context.Context, param domain.EnsNameParam) (domain.EnsRecord, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.EnsRecord{}, err
+	}
+	return s.next.ResolveEnsName(ctx, param)
+}
+
+func (s *AuthService) LookupEnsName(ctx context.Context, param domain.EnsAddressParam) (domain.EnsRecord, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.EnsRecord{}, err
+	}
+	return s.next.LookupEnsName(ctx, param)
+}
+
+func (s *AuthService) GetNonceStatus(ctx context.Context, param domain.NonceStatusParam) (domain.NonceStatus, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.NonceStatus{}, err
+	}
+	return s.next.GetNonceStatus(ctx, param)
+}
+
+func (s *AuthService) GetFee(ctx context.Context, param domain.FeeParam) (domain.Fee, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.Fee{}, err
+	}
+	return s.next.GetFee(ctx, param)
+}
+
+func (s *AuthService) SendTx(ctx context.Context, param domain.SendTxParam) (string, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassBroadcast); err != nil {
+		return "", err
+	}
+	return s.next.SendTx(ctx, param)
+}
+
+func (s *AuthService) SimulateTransaction(ctx context.Context, param domain.SimulateTransactionParam) (domain.SimulationResult, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.SimulationResult{}, err
+	}
+	return s.next.SimulateTransaction(ctx, param)
+}
+
+func (s *AuthService) ListTxByAddress(ctx context.Context, param domain.TxAddressParam) ([]domain.TxMessage, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return nil, err
+	}
+	return s.next.ListTxByAddress(ctx, param)
+}
+
+func (s *AuthService) GetTxByHash(ctx context.Context, param domain.GetTxByHashParam) (domain.TxMessage, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return domain.TxMessage{}, err
+	}
+	return s.next.GetTxByHash(ctx, param)
+}
+
+func (s *AuthService) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (domain.UnSignTransaction, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassSign); err != nil {
+		return domain.UnSignTransaction{}, err
+	}
+	return s.next.CreateUnSignTransaction(ctx, param)
+}
+
+func (s *AuthService) CreateReplacementTransaction(ctx context.Context, param domain.ReplacementTransactionParam) (domain.ReplacementTransaction, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassSign); err != nil {
+		return domain.ReplacementTransaction{}, err
+	}
+	return s.next.CreateReplacementTransaction(ctx, param)
+}
+
+func (s *AuthService) CreateUnSignAuthorization(ctx context.Context, param domain.UnSignAuthorizationParam) (string, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassSign); err != nil {
+		return "", err
+	}
+	return s.next.CreateUnSignAuthorization(ctx, param)
+}
+
+func (s *AuthService) BuildSignedTransaction(ctx context.Context, param domain.SignedTransactionParam) (domain.SignedTransaction, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassSign); err != nil {
+		return domain.SignedTransaction{}, err
+	}
+	return s.next.BuildSignedTransaction(ctx, param)
+}
+
+func (s *AuthService) DecodeTransaction(ctx context.Context, param domain.DecodeTransactionParam) (string, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return "", err
+	}
+	return s.next.DecodeTransaction(ctx, param)
+}
+
+func (s *AuthService) VerifySignedTransaction(ctx context.Context, param domain.VerifyTransactionParam) (bool, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return false, err
+	}
+	return s.next.VerifySignedTransaction(ctx, param)
+}
+
+func (s *AuthService) GetExtraData(ctx context.Context, param domain.ExtraDataParam) (string, error) {
+	if err := s.authorize(param.ConsumerToken, param.Chain, MethodClassRead); err != nil {
+		return "", err
+	}
+	return s.next.GetExtraData(ctx, param)
+}
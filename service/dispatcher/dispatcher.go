@@ -0,0 +1,343 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var _ service.WalletAccountService = (*ChainDispatcher)(nil)
+
+// UnsupportedChainError 请求的 chain + network 没有注册任何实现
+type UnsupportedChainError struct {
+	Chain   string
+	Network string
+}
+
+func (e *UnsupportedChainError) Error() string {
+	return fmt.Sprintf("%s: chain=%s network=%s", config.UnsupportedChain, e.Chain, e.Network)
+}
+
+func (e *UnsupportedChainError) Unwrap() error {
+	return errcode.ErrUnsupported
+}
+
+// ChainDispatcher 本身实现 service.WalletAccountService，
+// 按请求中的 Chain + Network 把调用转发到已注册的链实现上。
+type ChainDispatcher struct {
+	mu       sync.RWMutex
+	network  string
+	registry map[string]service.WalletAccountService
+}
+
+// NewChainDispatcher 根据 config.Chains 为每条链创建实现，并以 config.NetWork 作为默认网络注册
+func NewChainDispatcher(ctx context.Context, conf *config.Config) (*ChainDispatcher, error) {
+	d := &ChainDispatcher{
+		network:  conf.NetWork,
+		registry: make(map[string]service.WalletAccountService),
+	}
+	registerChainProfiles(conf.ChainProfiles)
+	for _, chain := range conf.Chains {
+		chain = strings.TrimSpace(chain)
+		factory, ok := factories[chain]
+		if !ok {
+			log.Warn("chain has no implementation, skip", "chain", chain)
+			continue
+		}
+		svc, err := factory(ctx, conf)
+		if err != nil {
+			log.Error("create chain service fail", "chain", chain, "err", err)
+			return nil, fmt.Errorf("create %s service fail: %w", chain, err)
+		}
+		d.Register(chain, conf.NetWork, svc)
+		log.Info("register chain service success", "chain", chain, "network", conf.NetWork)
+	}
+	return d, nil
+}
+
+// Register 注册（或替换）某条链某个网络的实现
+func (d *ChainDispatcher) Register(chain, network string, svc service.WalletAccountService) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.registry[d.key(chain, network)] = svc
+}
+
+// Chains 返回已注册的 chain + network 列表
+func (d *ChainDispatcher) Chains() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	keys := make([]string, 0, len(d.registry))
+	for key := range d.registry {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// key 请求未携带 network 时使用配置中的默认网络
+func (d *ChainDispatcher) key(chain, network string) string {
+	if network == "" {
+		network = d.network
+	}
+	return chain + ":" + network
+}
+
+func (d *ChainDispatcher) route(chain, network string) (service.WalletAccountService, error) {
+	d.mu.RLock()
+	svc, ok := d.registry[d.key(chain, network)]
+	d.mu.RUnlock()
+	if !ok {
+		err := &UnsupportedChainError{Chain: chain, Network: network}
+		log.Warn("route chain service fail", "err", err)
+		return nil, err
+	}
+	return svc, nil
+}
+
+func (d *ChainDispatcher) GetSupportChains(_ context.Context, param domain.SupportChainsParam) (bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, ok := d.registry[d.key(param.Chain, param.Network)]
+	return ok, nil
+}
+
+// GetChainCapabilities Chain 为空时汇总所有已注册链的能力清单，
+// 未实现能力清单的链只返回链名和网络，不视为错误。
+func (d *ChainDispatcher) GetChainCapabilities(ctx context.Context, param domain.ChainCapabilitiesParam) ([]domain.ChainCapability, error) {
+	keys := []string{d.key(param.Chain, param.Network)}
+	if param.Chain == "" {
+		keys = d.Chains()
+		sort.Strings(keys)
+	}
+	capabilities := make([]domain.ChainCapability, 0, len(keys))
+	for _, key := range keys {
+		chain, network, _ := strings.Cut(key, ":")
+		svc, err := d.route(chain, network)
+		if err != nil {
+			return nil, err
+		}
+		items, err := svc.GetChainCapabilities(ctx, domain.ChainCapabilitiesParam{
+			ConsumerToken: param.ConsumerToken,
+			Chain:         chain,
+			Network:       network,
+		})
+		if errors.Is(err, errcode.ErrUnimplemented) {
+			items = []domain.ChainCapability{{}}
+		} else if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			item.Chain = chain
+			item.Network = network
+			capabilities = append(capabilities, item)
+		}
+	}
+	return capabilities, nil
+}
+
+func (d *ChainDispatcher) ConvertAddress(ctx context.Context, param domain.ConvertAddressParam) (string, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return "", err
+	}
+	return svc.ConvertAddress(ctx, param)
+}
+
+func (d *ChainDispatcher) ValidAddress(ctx context.Context, param domain.ValidAddressParam) (domain.AddressValidation, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.AddressValidation{}, err
+	}
+	return svc.ValidAddress(ctx, param)
+}
+
+func (d *ChainDispatcher) GetBlockByNumber(ctx context.Context, param domain.BlockNumberParam) (domain.Block, error) {
+	svc, err := d.route(param.Chain, "")
+	if err != nil {
+		return domain.Block{}, err
+	}
+	return svc.GetBlockByNumber(ctx, param)
+}
+
+func (d *ChainDispatcher) GetBlockByHash(ctx context.Context, param domain.BlockHashParam) (domain.Block, error) {
+	svc, err := d.route(param.Chain, "")
+	if err != nil {
+		return domain.Block{}, err
+	}
+	return svc.GetBlockByHash(ctx, param)
+}
+
+func (d *ChainDispatcher) GetBlockHeaderByHash(ctx context.Context, param domain.BlockHeaderHashParam) (domain.BlockHeader, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.BlockHeader{}, err
+	}
+	return svc.GetBlockHeaderByHash(ctx, param)
+}
+
+func (d *ChainDispatcher) GetBlockHeaderByNumber(ctx context.Context, param domain.BlockHeaderNumberParam) (domain.BlockHeader, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.BlockHeader{}, err
+	}
+	return svc.GetBlockHeaderByNumber(ctx, param)
+}
+
+func (d *ChainDispatcher) ListBlockHeaderByRange(ctx context.Context, param domain.BlockHeaderByRangeParam) ([]domain.BlockHeader, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return nil, err
+	}
+	return svc.ListBlockHeaderByRange(ctx, param)
+}
+
+func (d *ChainDispatcher) GetAccount(ctx context.Context, param domain.AccountParam) (domain.Account, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	return svc.GetAccount(ctx, param)
+}
+
+func (d *ChainDispatcher) GetBalances(ctx context.Context, param domain.BalancesParam) (domain.Balances, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.Balances{}, err
+	}
+	return svc.GetBalances(ctx, param)
+}
+
+func (d *ChainDispatcher) GetTokenInfo(ctx context.Context, param domain.TokenInfoParam) (domain.TokenInfo, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.TokenInfo{}, err
+	}
+	return svc.GetTokenInfo(ctx, param)
+}
+
+func (d *ChainDispatcher) ResolveEnsName(ctx context.Context, param domain.EnsNameParam) (domain.EnsRecord, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.EnsRecord{}, err
+	}
+	return svc.ResolveEnsName(ctx, param)
+}
+
+func (d *ChainDispatcher) LookupEnsName(ctx context.Context, param domain.EnsAddressParam) (domain.EnsRecord, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.EnsRecord{}, err
+	}
+	return svc.LookupEnsName(ctx, param)
+}
+
+func (d *ChainDispatcher) GetNonceStatus(ctx context.Context, param domain.NonceStatusParam) (domain.NonceStatus, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.NonceStatus{}, err
+	}
+	return svc.GetNonceStatus(ctx, param)
+}
+
+func (d *ChainDispatcher) GetFee(ctx context.Context, param domain.FeeParam) (domain.Fee, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.Fee{}, err
+	}
+	return svc.GetFee(ctx, param)
+}
+
+func (d *ChainDispatcher) SendTx(ctx context.Context, param domain.SendTxParam) (string, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return "", err
+	}
+	return svc.SendTx(ctx, param)
+}
+
+func (d *ChainDispatcher) SimulateTransaction(ctx context.Context, param domain.SimulateTransactionParam) (domain.SimulationResult, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.SimulationResult{}, err
+	}
+	return svc.SimulateTransaction(ctx, param)
+}
+
+func (d *ChainDispatcher) ListTxByAddress(ctx context.Context, param domain.TxAddressParam) ([]domain.TxMessage, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return nil, err
+	}
+	return svc.ListTxByAddress(ctx, param)
+}
+
+func (d *ChainDispatcher) GetTxByHash(ctx context.Context, param domain.GetTxByHashParam) (domain.TxMessage, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.TxMessage{}, err
+	}
+	return svc.GetTxByHash(ctx, param)
+}
+
+func (d *ChainDispatcher) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (domain.UnSignTransaction, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.UnSignTransaction{}, err
+	}
+	return svc.CreateUnSignTransaction(ctx, param)
+}
+
+func (d *ChainDispatcher) CreateReplacementTransaction(ctx context.Context, param domain.ReplacementTransactionParam) (domain.ReplacementTransaction, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.ReplacementTransaction{}, err
+	}
+	return svc.CreateReplacementTransaction(ctx, param)
+}
+
+func (d *ChainDispatcher) CreateUnSignAuthorization(ctx context.Context, param domain.UnSignAuthorizationParam) (string, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return "", err
+	}
+	return svc.CreateUnSignAuthorization(ctx, param)
+}
+
+func (d *ChainDispatcher) BuildSignedTransaction(ctx context.Context, param domain.SignedTransactionParam) (domain.SignedTransaction, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return domain.SignedTransaction{}, err
+	}
+	return svc.BuildSignedTransaction(ctx, param)
+}
+
+func (d *ChainDispatcher) DecodeTransaction(ctx context.Context, param domain.DecodeTransactionParam) (string, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return "", err
+	}
+	return svc.DecodeTransaction(ctx, param)
+}
+
+func (d *ChainDispatcher) VerifySignedTransaction(ctx context.Context, param domain.VerifyTransactionParam) (bool, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return false, err
+	}
+	return svc.VerifySignedTransaction(ctx, param)
+}
+
+func (d *ChainDispatcher) GetExtraData(ctx context.Context, param domain.ExtraDataParam) (string, error) {
+	svc, err := d.route(param.Chain, param.Network)
+	if err != nil {
+		return "", err
+	}
+	return svc.GetExtraData(ctx, param)
+}
@@ -0,0 +1,161 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/go-resty/resty/v2"
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/service"
+	"github.com/web3-fighter/wallet-chain-account/service/evm"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"github.com/web3-fighter/wallet-chain-account/service/solana"
+	"github.com/web3-fighter/wallet-chain-account/service/svmbase"
+	"time"
+)
+
+// ServiceFactory 根据配置创建某条链的 WalletAccountService 实现
+type ServiceFactory func(ctx context.Context, conf *config.Config) (service.WalletAccountService, error)
+
+// factories 链名 -> 构造方法，config.Chains 中出现的链名会在这里查找对应实现
+var factories = map[string]ServiceFactory{
+	solana.ChainName: newSolanaService,
+}
+
+// evmChain 一条 EVM 链的默认参数及其在 config.WalletNode 中的节点配置
+type evmChain struct {
+	conf evm.ChainConfig
+	node func(nodes config.WalletNode) config.Node
+}
+
+var defaultEvmFeatures = evm.Features{TokenTransfer: true, NFTTransfer: true, ContractCall: true}
+
+// evmChains 所有 EVM 链共用 evm.EVMNodeService，按链名各注册一个实例
+var evmChains = []evmChain{
+	{
+		conf: evm.ChainConfig{ChainName: evm.Ethereum, ChainId: domain.EthereumChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Eth },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Arbitrum, ChainId: domain.ArbitrumChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Arbi },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Optimism, ChainId: domain.OpChinId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Op },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Zksync, ChainId: domain.ZksyncChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Zksync },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.BscChain, ChainId: domain.BscChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Bsc },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Polygon, ChainId: domain.PolygonPosChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Polygon },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Mantle, ChainId: domain.MantleChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Mantle },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Scroll, ChainId: domain.ScrollChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Scroll },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Base, ChainId: domain.BaseChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Base },
+	},
+	{
+		conf: evm.ChainConfig{ChainName: evm.Linea, ChainId: domain.LineaChainId, Features: defaultEvmFeatures},
+		node: func(nodes config.WalletNode) config.Node { return nodes.Linea },
+	},
+}
+
+func init() {
+	for _, chain := range evmChains {
+		factories[chain.conf.ChainName] = newEvmService(chain)
+	}
+}
+
+// registerChainProfiles 配置中的 chain_profiles 合并到内置 profile，须在创建 EVM 服务之前调用
+func registerChainProfiles(profiles []config.ChainProfile) {
+	for _, profile := range profiles {
+		evmbase.RegisterProfile(mergeChainProfile(evmbase.ProfileOf(profile.ChainId), profile))
+		log.Info("register chain profile", "chainId", profile.ChainId)
+	}
+}
+
+// mergeChainProfile 只用配置中给出的字段覆盖 base，未配置的 ens_registry / multicall3 等保留内置值
+func mergeChainProfile(base evmbase.ChainProfile, override config.ChainProfile) evmbase.ChainProfile {
+	setBool := func(dst *bool, src *bool) {
+		if src != nil {
+			*dst = *src
+		}
+	}
+	setBool(&base.Batch, override.Batch)
+	setBool(&base.EIP1559, override.EIP1559)
+	setBool(&base.EIP4844, override.EIP4844)
+	setBool(&base.EIP7702, override.EIP7702)
+	setBool(&base.SafeTag, override.SafeTag)
+	setBool(&base.FinalizedTag, override.FinalizedTag)
+	if override.MaxBatchSize > 0 {
+		base.MaxBatchSize = override.MaxBatchSize
+	}
+	if override.Multicall3 != "" {
+		base.Multicall3 = common.HexToAddress(override.Multicall3)
+	}
+	if override.EnsRegistry != "" {
+		base.EnsRegistry = common.HexToAddress(override.EnsRegistry)
+	}
+	return base
+}
+
+func newEvmService(chain evmChain) ServiceFactory {
+	return func(ctx context.Context, conf *config.Config) (service.WalletAccountService, error) {
+		node := chain.node(conf.WalletNode)
+		evmClient, err := evmbase.DialEthClients(ctx, node.Endpoints(), chain.conf.ChainId, evmbase.MultiRPCConfig{BroadcastAll: node.BroadcastAll})
+		if err != nil {
+			log.Error("dial evm client fail", "chain", chain.conf.ChainName, "err", err)
+			return nil, fmt.Errorf("dial %s client fail: %w", chain.conf.ChainName, err)
+		}
+		var dataClient *evmbase.EthScan
+		if node.DataApiUrl != "" {
+			dataClient, err = evmbase.NewEthDataClient(node.DataApiUrl, node.DataApiKey, time.Duration(node.TimeOut)*time.Second)
+			if err != nil {
+				return nil, fmt.Errorf("new %s data client fail: %w", chain.conf.ChainName, err)
+			}
+		}
+		if n := len(node.FeePercentiles); n != 0 && n != 3 {
+			return nil, fmt.Errorf("%s fee_percentiles must have 3 values (slow, normal, fast), got %d", chain.conf.ChainName, n)
+		}
+		chainConf := chain.conf
+		chainConf.Features.InternalTransfer = node.DebugTrace
+		chainConf.GasLimitMargin = node.GasLimitMargin
+		chainConf.MaxHeaderRange = node.MaxHeaderRange
+		chainConf.Fee = evm.FeeConfig{
+			Percentiles:     node.FeePercentiles,
+			BaseFeeHeadroom: node.BaseFeeHeadroom,
+			HistoryBlocks:   node.FeeHistoryBlocks,
+		}
+		return evm.NewEVMNodeService(chainConf, evmClient, dataClient), nil
+	}
+}
+
+func newSolanaService(_ context.Context, conf *config.Config) (service.WalletAccountService, error) {
+	node := conf.WalletNode.Sol
+	restyClient := resty.New()
+	restyClient.SetBaseURL(node.RpcUrl)
+	restyClient.SetTimeout(time.Duration(node.TimeOut) * time.Second)
+	svmClient := svmbase.NewSVMClient(restyClient)
+	solData, err := svmbase.NewSolScanClient(node.DataApiUrl, node.DataApiKey, time.Duration(node.TimeOut)*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("new solana data client fail: %w", err)
+	}
+	return solana.NewSOLNodeService(svmClient, rpc.New(node.RpcUrl), solData), nil
+}
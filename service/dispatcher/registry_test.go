@@ -0,0 +1,56 @@
+package dispatcher
+
+import (
+	"github.com/web3-fighter/wallet-chain-account/config"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"testing"
+)
+
+func TestRegisterChainProfilesPartialOverride(t *testing.T) {
+	builtin := evmbase.ProfileOf(domain.EthereumChainId)
+	t.Cleanup(func() {
+		evmbase.RegisterProfile(builtin)
+	})
+
+	disabled := false
+	registerChainProfiles([]config.ChainProfile{{
+		ChainId:      domain.EthereumChainId,
+		MaxBatchSize: 20,
+		EIP4844:      &disabled,
+	}})
+
+	got := evmbase.ProfileOf(domain.EthereumChainId)
+	if got.MaxBatchSize != 20 || got.EIP4844 {
+		t.Fatalf("configured fields not applied: %+v", got)
+	}
+	// 未配置 ens_registry、multicall3 及其余开关时保留内置值
+	if got.EnsRegistry != evmbase.DefaultEnsRegistry {
+		t.Fatalf("ens registry = %s, want built-in %s", got.EnsRegistry, evmbase.DefaultEnsRegistry)
+	}
+	if got.Multicall3Address() != builtin.Multicall3Address() {
+		t.Fatalf("multicall3 = %s, want built-in %s", got.Multicall3Address(), builtin.Multicall3Address())
+	}
+	if !got.Batch || !got.EIP1559 || !got.EIP7702 || !got.SafeTag || !got.FinalizedTag {
+		t.Fatalf("unconfigured flags changed: %+v", got)
+	}
+}
+
+func TestRegisterChainProfilesNewChain(t *testing.T) {
+	const chainId = 424242
+	enabled := true
+	registerChainProfiles([]config.ChainProfile{{
+		ChainId:     chainId,
+		EIP7702:     &enabled,
+		EnsRegistry: "0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e",
+	}})
+
+	got := evmbase.ProfileOf(chainId)
+	if !got.EIP7702 || got.EnsRegistry != evmbase.DefaultEnsRegistry {
+		t.Fatalf("configured fields not applied: %+v", got)
+	}
+	// 未登记的链以默认 profile 为基础
+	if !got.Batch || !got.EIP1559 || got.MaxBatchSize == 0 {
+		t.Fatalf("default profile fields lost: %+v", got)
+	}
+}
@@ -0,0 +1,136 @@
+package evm
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"math/big"
+)
+
+// maxBalanceQueries GetBalances 单次最多查询的 (地址, 代币) 对数，避免 Multicall3 调用超出节点的 eth_call gas 上限
+const maxBalanceQueries = 500
+
+// balanceOf 从节点读取 owner 的余额：token 为空或零地址时为原生币余额，否则为 ERC20 balanceOf
+func (s *EVMNodeService) balanceOf(ctx context.Context, owner common.Address, token string) (*big.Int, error) {
+	if isNativeToken(token) {
+		balance, err := s.evmClient.GetBalance(ctx, owner)
+		if err != nil {
+			log.Error("get balance fail", "err", err)
+			return nil, wrapRpcError(err, "get balance fail")
+		}
+		return balance, nil
+	}
+	if !common.IsHexAddress(token) {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid contract address: %s", token)
+	}
+	tokenAddress := common.HexToAddress(token)
+	result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{
+		To:   &tokenAddress,
+		Data: evmbase.BuildErc20BalanceOfData(owner),
+	}, nil)
+	if err != nil {
+		log.Error("call balanceOf fail", "token", token, "err", err)
+		return nil, wrapRpcError(err, "call balanceOf fail")
+	}
+	balance, err := evmbase.UnpackUint256(result)
+	if err != nil {
+		// 非合约地址或合约没有 balanceOf 时 eth_call 返回空数据
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "%s is not an erc20 token", token)
+	}
+	return balance, nil
+}
+
+// GetBalances 通过一次 Multicall3 tryBlockAndAggregate 调用批量查询原生币（getEthBalance）及 ERC20 余额，
+// 单个查询失败（如不是 ERC20 合约）只体现在对应条目的 Error 中；Height 为 0 时查询最新区块，否则查询历史区块（需归档节点）；
+// 查询地址可以是 ENS 名称，按最新状态解析，响应中 Address 为解析出的地址、Name 为该名称
+func (s *EVMNodeService) GetBalances(ctx context.Context, param domain.BalancesParam) (domain.Balances, error) {
+	if len(param.Queries) == 0 {
+		return domain.Balances{}, errcode.New(errcode.InvalidArgument, "no balance queries")
+	}
+	if len(param.Queries) > maxBalanceQueries {
+		return domain.Balances{}, errcode.New(errcode.InvalidArgument, "too many balance queries: %d > %d", len(param.Queries), maxBalanceQueries)
+	}
+	multicall3 := s.profile().Multicall3Address()
+	calls := make([]evmbase.MulticallCall, len(param.Queries))
+	// 查询地址为 ENS 名称时响应中给出解析出的地址，十六进制地址原样返回
+	addresses := make([]string, len(param.Queries))
+	names := make([]string, len(param.Queries))
+	for i, query := range param.Queries {
+		owner, name, err := s.resolveAddress(ctx, query.Address)
+		if err != nil {
+			return domain.Balances{}, err
+		}
+		addresses[i], names[i] = query.Address, name
+		if name != "" {
+			addresses[i] = owner.Hex()
+		}
+		if isNativeToken(query.ContractAddress) {
+			calls[i] = evmbase.MulticallCall{Target: multicall3, CallData: evmbase.BuildEthBalanceData(owner)}
+			continue
+		}
+		if !common.IsHexAddress(query.ContractAddress) {
+			return domain.Balances{}, errcode.New(errcode.InvalidArgument, "invalid contract address: %s", query.ContractAddress)
+		}
+		calls[i] = evmbase.MulticallCall{Target: common.HexToAddress(query.ContractAddress), CallData: evmbase.BuildErc20BalanceOfData(owner)}
+	}
+	data, err := evmbase.PackMulticall(calls)
+	if err != nil {
+		return domain.Balances{}, errcode.Wrap(errcode.Unknown, err, "pack multicall fail")
+	}
+
+	var blockNumber *big.Int
+	if param.Height != 0 {
+		blockNumber = new(big.Int).SetUint64(param.Height)
+	}
+	result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{To: &multicall3, Data: data}, blockNumber)
+	if err != nil {
+		log.Error("call multicall3 fail", "chain", s.conf.ChainName, "err", err)
+		return domain.Balances{}, wrapRpcError(err, "call multicall3 fail")
+	}
+	height, results, err := evmbase.UnpackMulticall(result)
+	if err != nil {
+		return domain.Balances{}, errcode.Wrap(errcode.Unsupported, err, "multicall3 unavailable at %s on %s", multicall3, s.conf.ChainName)
+	}
+	if len(results) != len(calls) {
+		return domain.Balances{}, errcode.New(errcode.UpstreamUnavailable, "multicall3 returned %d results for %d calls", len(results), len(calls))
+	}
+
+	tokenAddresses := make([]string, len(param.Queries))
+	for i, query := range param.Queries {
+		tokenAddresses[i] = query.ContractAddress
+	}
+	metas := s.tokenMetas(ctx, tokenAddresses)
+	balances := domain.Balances{
+		Height:   height.Uint64(),
+		Balances: make([]domain.TokenBalance, len(param.Queries)),
+	}
+	for i, query := range param.Queries {
+		item := domain.TokenBalance{
+			Address:         addresses[i],
+			ContractAddress: query.ContractAddress,
+			Decimals:        metas[query.ContractAddress].Decimals,
+			Symbol:          metas[query.ContractAddress].Symbol,
+			Name:            names[i],
+		}
+		if !results[i].Success {
+			item.Error = "balance call reverted"
+		} else if balance, err := evmbase.UnpackUint256(results[i].ReturnData); err != nil {
+			item.Error = "not an erc20 token"
+		} else {
+			item.Balance = balance.String()
+		}
+		balances.Balances[i] = item
+	}
+	return balances, nil
+}
+
+// isNativeToken 合约地址为空或零地址表示原生币
+func isNativeToken(contractAddress string) bool {
+	return contractAddress == "" ||
+		contractAddress == "0x0000000000000000000000000000000000000000" ||
+		contractAddress == "0x00"
+}
@@ -0,0 +1,55 @@
+package evm
+
+// EVM 链名，与 config.Chains 中的取值保持一致
+const (
+	Ethereum = "Ethereum"
+	Arbitrum = "Arbitrum"
+	Optimism = "Optimism"
+	Zksync   = "Zksync"
+	BscChain = "BscChain"
+	Polygon  = "Polygon"
+	Mantle   = "Mantle"
+	Scroll   = "Scroll"
+	Base     = "Base"
+	Linea    = "Linea"
+)
+
+// defaultGasLimitMargin eth_estimateGas 的估算值默认上浮 20%，避免状态变化导致 out of gas
+const defaultGasLimitMargin = 20
+
+// defaultMaxHeaderRange ListBlockHeaderByRange 默认单次最多返回 1000 个区块头
+const defaultMaxHeaderRange = 1000
+
+// Features 各 EVM 链的业务开关，协议层面的差异（批量请求、EIP-1559/4844 等）见 evmbase.ChainProfile
+type Features struct {
+	// TokenTransfer 支持 ERC20 transfer 的构造，以及按日志解析 ERC20 / ERC721 / ERC1155 转账
+	TokenTransfer bool
+	// NFTTransfer 支持 ERC721 / ERC1155 safeTransferFrom 的构造与解析
+	NFTTransfer bool
+	// ContractCall 支持按 ABI 或原始调用数据构造任意合约调用
+	ContractCall bool
+	// InternalTransfer 通过 debug_trace* 解析合约内部调用产生的原生币转账，需节点支持
+	InternalTransfer bool
+}
+
+// ChainConfig 一条 EVM 链的参数，EVMNodeService 按此区分不同链
+type ChainConfig struct {
+	ChainName string
+	ChainId   uint64
+	Features  Features
+	// GasLimitMargin eth_estimateGas 结果额外增加的百分比，0 使用默认值
+	GasLimitMargin uint64
+	Fee            FeeConfig
+	// MaxHeaderRange ListBlockHeaderByRange 单次最多返回的区块头数，0 使用默认值
+	MaxHeaderRange uint64
+}
+
+// FeeConfig GetFee 档位的计算参数，零值字段使用默认值
+type FeeConfig struct {
+	// Percentiles 慢 / 中 / 快三档的分位数
+	Percentiles []float64
+	// BaseFeeHeadroom maxFeePerGas = 下一区块 base fee * (100 + BaseFeeHeadroom) / 100 + maxPriorityFeePerGas
+	BaseFeeHeadroom uint64
+	// HistoryBlocks eth_feeHistory 统计的区块数
+	HistoryBlocks uint64
+}
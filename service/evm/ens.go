@@ -0,0 +1,150 @@
+package evm
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+)
+
+// ResolveEnsName 正向解析：Registry.resolver(namehash) 得到解析器，再调用 Resolver.addr(namehash)；
+// 不支持 ENSIP-10 通配符解析及 CCIP-Read 链下解析
+func (s *EVMNodeService) ResolveEnsName(ctx context.Context, param domain.EnsNameParam) (domain.EnsRecord, error) {
+	name, err := normalizeEnsName(param.Name)
+	if err != nil {
+		return domain.EnsRecord{}, err
+	}
+	address, err := s.resolveEnsName(ctx, name)
+	if err != nil {
+		return domain.EnsRecord{}, err
+	}
+	return domain.EnsRecord{Name: name, Address: address.Hex()}, nil
+}
+
+// LookupEnsName 反向解析地址的主名称（<address>.addr.reverse 节点的 name），
+// 并按 ENS 规范正向解析该名称，不指回该地址的视为没有主名称。
+// 与其他地址参数不同，这里只接受十六进制地址：传入名称时反查结果就是名称本身或验证失败，没有意义，名称请用 ResolveEnsName
+func (s *EVMNodeService) LookupEnsName(ctx context.Context, param domain.EnsAddressParam) (domain.EnsRecord, error) {
+	if evmbase.IsEnsName(param.Address) {
+		return domain.EnsRecord{}, errcode.New(errcode.InvalidArgument, "%s is an ens name, use ResolveEnsName", param.Address)
+	}
+	if !common.IsHexAddress(param.Address) {
+		return domain.EnsRecord{}, errcode.New(errcode.InvalidArgument, "invalid address: %s", param.Address)
+	}
+	address := common.HexToAddress(param.Address)
+	registry, err := s.ensRegistry()
+	if err != nil {
+		return domain.EnsRecord{}, err
+	}
+	node := evmbase.ReverseNode(address)
+	resolver, err := s.ensResolver(ctx, registry, node)
+	if err != nil {
+		return domain.EnsRecord{}, err
+	}
+	if resolver == (common.Address{}) {
+		return domain.EnsRecord{}, errcode.New(errcode.NotFound, "%s has no ens primary name", address.Hex())
+	}
+	result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{To: &resolver, Data: evmbase.BuildEnsNameData(node)}, nil)
+	if err != nil {
+		log.Error("call ens resolver name fail", "address", address, "err", err)
+		return domain.EnsRecord{}, wrapRpcError(err, "call ens resolver name fail")
+	}
+	reverseName, err := evmbase.UnpackEnsName(result)
+	if err != nil || reverseName == "" {
+		return domain.EnsRecord{}, errcode.New(errcode.NotFound, "%s has no ens primary name", address.Hex())
+	}
+	name, err := normalizeEnsName(reverseName)
+	if err != nil {
+		return domain.EnsRecord{}, errcode.Wrap(errcode.NotFound, err, "%s has an invalid ens primary name", address.Hex())
+	}
+	// 反向记录由地址所有者自行设置，可以填任意名称，必须正向解析回该地址才可信
+	forward, err := s.resolveEnsName(ctx, name)
+	if err != nil && errcode.CodeOf(err) != errcode.NotFound {
+		return domain.EnsRecord{}, err
+	}
+	if forward != address {
+		log.Warn("ens primary name does not resolve back to address", "address", address, "name", name, "resolved", forward)
+		return domain.EnsRecord{}, errcode.New(errcode.NotFound, "%s has no verified ens primary name", address.Hex())
+	}
+	return domain.EnsRecord{Name: name, Address: address.Hex()}, nil
+}
+
+// resolveAddress 地址参数可以是十六进制地址或 ENS 名称，返回解析后的地址及（规范化后的）名称，
+// 十六进制地址原样返回、名称为空
+func (s *EVMNodeService) resolveAddress(ctx context.Context, addressOrName string) (common.Address, string, error) {
+	if !evmbase.IsEnsName(addressOrName) {
+		if !common.IsHexAddress(addressOrName) {
+			return common.Address{}, "", errcode.New(errcode.InvalidArgument, "invalid address: %s", addressOrName)
+		}
+		return common.HexToAddress(addressOrName), "", nil
+	}
+	name, err := normalizeEnsName(addressOrName)
+	if err != nil {
+		return common.Address{}, "", err
+	}
+	address, err := s.resolveEnsName(ctx, name)
+	if err != nil {
+		return common.Address{}, "", err
+	}
+	return address, name, nil
+}
+
+// resolveEnsName name 须已规范化；没有解析器或未设置地址时返回 NotFound
+func (s *EVMNodeService) resolveEnsName(ctx context.Context, name string) (common.Address, error) {
+	registry, err := s.ensRegistry()
+	if err != nil {
+		return common.Address{}, err
+	}
+	node := evmbase.Namehash(name)
+	resolver, err := s.ensResolver(ctx, registry, node)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if resolver == (common.Address{}) {
+		return common.Address{}, errcode.New(errcode.NotFound, "ens name %s not found", name)
+	}
+	result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{To: &resolver, Data: evmbase.BuildEnsAddrData(node)}, nil)
+	if err != nil {
+		log.Error("call ens resolver addr fail", "name", name, "err", err)
+		return common.Address{}, wrapRpcError(err, "call ens resolver addr fail")
+	}
+	address, err := evmbase.UnpackAddress(result)
+	if err != nil || address == (common.Address{}) {
+		return common.Address{}, errcode.New(errcode.NotFound, "ens name %s has no address", name)
+	}
+	log.Info("evm resolve ens name", "chain", s.conf.ChainName, "name", name, "address", address)
+	return address, nil
+}
+
+func (s *EVMNodeService) ensResolver(ctx context.Context, registry common.Address, node common.Hash) (common.Address, error) {
+	result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{To: &registry, Data: evmbase.BuildEnsResolverData(node)}, nil)
+	if err != nil {
+		log.Error("call ens registry resolver fail", "registry", registry, "err", err)
+		return common.Address{}, wrapRpcError(err, "call ens registry resolver fail")
+	}
+	resolver, err := evmbase.UnpackAddress(result)
+	if err != nil {
+		// Registry 地址上没有合约时 eth_call 返回空数据
+		return common.Address{}, errcode.Wrap(errcode.Unsupported, err, "ens registry unavailable at %s on %s", registry, s.conf.ChainName)
+	}
+	return resolver, nil
+}
+
+func (s *EVMNodeService) ensRegistry() (common.Address, error) {
+	registry := s.profile().EnsRegistry
+	if registry == (common.Address{}) {
+		return common.Address{}, errcode.New(errcode.Unsupported, "%s does not support ens", s.conf.ChainName)
+	}
+	return registry, nil
+}
+
+func normalizeEnsName(name string) (string, error) {
+	normalized, err := evmbase.NormalizeEnsName(name)
+	if err != nil {
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "invalid ens name: %s", name)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,320 @@
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// simulatedChainId ethclient/simulated 模拟链固定使用的 chain ID
+const simulatedChainId = 1337
+
+var testEnsToken = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+
+// ensRecordStoreCode 作为 Registry 与 Resolver 部署的记录合约：
+// calldata 为 36 字节（selector + node，即 resolver / addr / name 的调用数据）时，返回以其 keccak256 为起始槽的连续 3 个存储字；
+// 否则将 calldata 前 36 字节视为键，其后的 3 个字写入对应存储槽。
+// 返回值按 ABI 编码写入：address 在第 1 个字，string 为 offset / length / 数据（名称不超过 32 字节）
+var ensRecordStoreCode = common.FromHex("0x" +
+	// initcode：将其后 0x43 字节的运行时代码拷贝到内存并返回
+	"604380600b6000396000f3" +
+	// 运行时：h = keccak256(calldata[0:36])，calldata 长度为 36 时跳转到读取分支
+	"6024600060003760246000206024361460295760243581556044358160010155606435816002015500" +
+	"5b805460005280600101546020526002015460405260606000f3")
+
+// ensTestChain 在模拟链上部署 ENS Registry 与 Resolver，并登记 simulatedChainId 的 profile 指向该 Registry
+type ensTestChain struct {
+	backend  *simulated.Backend
+	key      *ecdsa.PrivateKey
+	from     common.Address
+	nonce    uint64
+	registry common.Address
+	resolver common.Address
+}
+
+func newEnsTestChain(t *testing.T) *ensTestChain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{from: {Balance: new(big.Int).Mul(big.NewInt(params.Ether), big.NewInt(100))}})
+	t.Cleanup(func() { backend.Close() })
+
+	chain := &ensTestChain{backend: backend, key: key, from: from}
+	chain.registry = chain.deploy(t)
+	chain.resolver = chain.deploy(t)
+
+	original := evmbase.ProfileOf(simulatedChainId)
+	profile := evmbase.ProfileOf(domain.EthereumChainId)
+	profile.ChainId = simulatedChainId
+	profile.EnsRegistry = chain.registry
+	evmbase.RegisterProfile(profile)
+	t.Cleanup(func() { evmbase.RegisterProfile(original) })
+	return chain
+}
+
+// send 发送交易并出块，要求交易执行成功
+func (c *ensTestChain) send(t *testing.T, to *common.Address, data []byte) *types.Receipt {
+	t.Helper()
+	tx, err := types.SignNewTx(c.key, types.LatestSignerForChainID(big.NewInt(simulatedChainId)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(simulatedChainId),
+		Nonce:     c.nonce,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(100 * params.GWei),
+		Gas:       200000,
+		To:        to,
+		Data:      data,
+	})
+	if err != nil {
+		t.Fatalf("sign tx: %v", err)
+	}
+	client := c.backend.Client()
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("send tx: %v", err)
+	}
+	c.nonce++
+	c.backend.Commit()
+	receipt, err := client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		t.Fatalf("get receipt: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("tx %s failed", tx.Hash())
+	}
+	return receipt
+}
+
+func (c *ensTestChain) deploy(t *testing.T) common.Address {
+	t.Helper()
+	return c.send(t, nil, ensRecordStoreCode).ContractAddress
+}
+
+// set 设置 contract 上 call（36 字节调用数据）的返回值
+func (c *ensTestChain) set(t *testing.T, contract common.Address, call []byte, words ...[]byte) {
+	t.Helper()
+	data := append([]byte{}, call...)
+	for _, word := range words {
+		data = append(data, common.LeftPadBytes(word, 32)...)
+	}
+	c.send(t, &contract, data)
+}
+
+func (c *ensTestChain) setResolver(t *testing.T, node common.Hash) {
+	t.Helper()
+	c.set(t, c.registry, evmbase.BuildEnsResolverData(node), c.resolver.Bytes())
+}
+
+// register 设置名称的正向记录；primary 为 true 时同时设置地址的反向记录
+func (c *ensTestChain) register(t *testing.T, name string, address common.Address, primary bool) {
+	t.Helper()
+	node := evmbase.Namehash(name)
+	c.setResolver(t, node)
+	c.set(t, c.resolver, evmbase.BuildEnsAddrData(node), address.Bytes())
+	if primary {
+		c.setReverse(t, address, name)
+	}
+}
+
+func (c *ensTestChain) setReverse(t *testing.T, address common.Address, name string) {
+	t.Helper()
+	node := evmbase.ReverseNode(address)
+	c.setResolver(t, node)
+	c.set(t, c.resolver, evmbase.BuildEnsNameData(node), big.NewInt(32).Bytes(), big.NewInt(int64(len(name))).Bytes(), common.RightPadBytes([]byte(name), 32))
+}
+
+func (c *ensTestChain) service(t *testing.T, chainId uint64) *EVMNodeService {
+	t.Helper()
+	client := c.backend.Client().(interface{ Client() *rpc.Client }).Client()
+	conf := ChainConfig{
+		ChainName: "Ethereum",
+		ChainId:   chainId,
+		Features:  Features{TokenTransfer: true},
+	}
+	return NewEVMNodeService(conf, evmbase.NewEVMClient(client, chainId), nil).(*EVMNodeService)
+}
+
+func TestResolveEnsName(t *testing.T) {
+	alice := common.HexToAddress("0x00000000000000000000000000000000000A11cE")
+	chain := newEnsTestChain(t)
+	chain.register(t, "alice.eth", alice, true)
+	chain.setResolver(t, evmbase.Namehash("empty.eth"))
+	s := chain.service(t, simulatedChainId)
+
+	tests := []struct {
+		name     string
+		ensName  string
+		want     common.Address
+		wantCode errcode.Code
+	}{
+		{name: "resolved", ensName: "alice.eth", want: alice},
+		{name: "normalized", ensName: " Alice.ETH. ", want: alice},
+		{name: "no resolver", ensName: "bob.eth", wantCode: errcode.NotFound},
+		{name: "no address", ensName: "empty.eth", wantCode: errcode.NotFound},
+		{name: "invalid name", ensName: "alice..eth", wantCode: errcode.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := s.ResolveEnsName(context.Background(), domain.EnsNameParam{Name: tt.ensName})
+			if tt.wantCode != errcode.Unknown {
+				if got := errcode.CodeOf(err); got != tt.wantCode {
+					t.Fatalf("error code = %s, want %s (err: %v)", got, tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveEnsName: %v", err)
+			}
+			if record.Name != "alice.eth" || record.Address != tt.want.Hex() {
+				t.Fatalf("unexpected record: %+v", record)
+			}
+		})
+	}
+
+	unsupported := chain.service(t, domain.BscChainId)
+	if _, err := unsupported.ResolveEnsName(context.Background(), domain.EnsNameParam{Name: "alice.eth"}); errcode.CodeOf(err) != errcode.Unsupported {
+		t.Fatalf("chain without ens registry: error = %v, want %s", err, errcode.Unsupported)
+	}
+}
+
+func TestLookupEnsName(t *testing.T) {
+	alice := common.HexToAddress("0x00000000000000000000000000000000000A11cE")
+	mallory := common.HexToAddress("0x000000000000000000000000000000000BADBAD0")
+	chain := newEnsTestChain(t)
+	chain.register(t, "alice.eth", alice, true)
+	// mallory 把反向记录指向 alice.eth，正向解析不指回 mallory，不能作为主名称
+	chain.setReverse(t, mallory, "alice.eth")
+	s := chain.service(t, simulatedChainId)
+
+	record, err := s.LookupEnsName(context.Background(), domain.EnsAddressParam{Address: alice.Hex()})
+	if err != nil {
+		t.Fatalf("LookupEnsName: %v", err)
+	}
+	if record.Name != "alice.eth" || record.Address != alice.Hex() {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+
+	if _, err := s.LookupEnsName(context.Background(), domain.EnsAddressParam{Address: mallory.Hex()}); errcode.CodeOf(err) != errcode.NotFound {
+		t.Fatalf("unverified primary name: error = %v, want %s", err, errcode.NotFound)
+	}
+	if _, err := s.LookupEnsName(context.Background(), domain.EnsAddressParam{Address: "alice.eth"}); errcode.CodeOf(err) != errcode.InvalidArgument {
+		t.Fatalf("lookup by ens name: error = %v, want %s", err, errcode.InvalidArgument)
+	}
+	unnamed := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	if _, err := s.LookupEnsName(context.Background(), domain.EnsAddressParam{Address: unnamed.Hex()}); errcode.CodeOf(err) != errcode.NotFound {
+		t.Fatalf("address without reverse record: error = %v, want %s", err, errcode.NotFound)
+	}
+}
+
+func TestGetNonceStatusWithEnsName(t *testing.T) {
+	chain := newEnsTestChain(t)
+	chain.register(t, "deployer.eth", chain.from, false)
+	s := chain.service(t, simulatedChainId)
+
+	status, err := s.GetNonceStatus(context.Background(), domain.NonceStatusParam{Address: "deployer.eth"})
+	if err != nil {
+		t.Fatalf("GetNonceStatus: %v", err)
+	}
+	if status.Address != chain.from.Hex() || status.Name != "deployer.eth" {
+		t.Fatalf("unexpected address echo: %+v", status)
+	}
+	if status.LatestNonce != chain.nonce || status.PendingNonce != chain.nonce {
+		t.Fatalf("nonce = %d / %d, want %d", status.LatestNonce, status.PendingNonce, chain.nonce)
+	}
+}
+
+func TestBuildTransactionWithEnsNames(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	recipient := common.HexToAddress("0x00000000000000000000000000000000000A11cE")
+	chain := newEnsTestChain(t)
+	chain.register(t, "sender.eth", sender, false)
+	chain.register(t, "alice.eth", recipient, false)
+	chain.register(t, "usdc.eth", testEnsToken, false)
+	s := chain.service(t, simulatedChainId)
+
+	encode := func(from, to, contract string) string {
+		txReq := Eip1559DynamicFeeTx{
+			ChainId:              strconv.FormatUint(simulatedChainId, 10),
+			Nonce:                3,
+			FromAddress:          from,
+			ToAddress:            to,
+			GasLimit:             60000,
+			MaxFeePerGas:         "30000000000",
+			MaxPriorityFeePerGas: "1000000000",
+			Amount:               "1000000",
+			ContractAddress:      contract,
+		}
+		txReqJson, err := json.Marshal(txReq)
+		if err != nil {
+			t.Fatalf("marshal tx request: %v", err)
+		}
+		return base64.StdEncoding.EncodeToString(txReqJson)
+	}
+
+	ctx := context.Background()
+	byName := encode("sender.eth", "alice.eth", "usdc.eth")
+	named, err := s.CreateUnSignTransaction(ctx, domain.UnSignTransactionParam{Base64Tx: byName})
+	if err != nil {
+		t.Fatalf("CreateUnSignTransaction with ens names: %v", err)
+	}
+	// 名称解析后与直接使用十六进制地址构造的交易完全一致
+	hex, err := s.CreateUnSignTransaction(ctx, domain.UnSignTransactionParam{Base64Tx: encode(sender.Hex(), recipient.Hex(), testEnsToken.Hex())})
+	if err != nil {
+		t.Fatalf("CreateUnSignTransaction with hex addresses: %v", err)
+	}
+	if named.UnSignTx != hex.UnSignTx {
+		t.Fatalf("unsigned tx hash = %s, want %s", named.UnSignTx, hex.UnSignTx)
+	}
+	// 名称及解析结果回显给签名方，十六进制地址不出现在 ResolvedAddresses 中
+	wantResolved := []domain.ResolvedAddress{
+		{Field: "from_address", Name: "sender.eth", Address: sender.Hex()},
+		{Field: "to_address", Name: "alice.eth", Address: recipient.Hex()},
+		{Field: "contract_address", Name: "usdc.eth", Address: testEnsToken.Hex()},
+	}
+	if !reflect.DeepEqual(named.ResolvedAddresses, wantResolved) {
+		t.Fatalf("resolved addresses = %+v, want %+v", named.ResolvedAddresses, wantResolved)
+	}
+	if named.Base64Tx == "" || hex.Base64Tx != "" || len(hex.ResolvedAddresses) != 0 {
+		t.Fatalf("base64 tx should only be returned when names were resolved: named=%+v hex=%+v", named, hex)
+	}
+
+	signature, err := crypto.Sign(common.HexToHash(named.UnSignTx).Bytes(), key)
+	if err != nil {
+		t.Fatalf("sign tx: %v", err)
+	}
+	// 签名阶段不再解析名称，须使用返回的 Base64Tx
+	if _, err := s.BuildSignedTransaction(ctx, domain.SignedTransactionParam{Base64Tx: byName, Signature: hexutil.Encode(signature)}); errcode.CodeOf(err) != errcode.InvalidArgument {
+		t.Fatalf("BuildSignedTransaction with ens names: error = %v, want %s", err, errcode.InvalidArgument)
+	}
+	signed, err := s.BuildSignedTransaction(ctx, domain.SignedTransactionParam{Base64Tx: named.Base64Tx, Signature: hexutil.Encode(signature)})
+	if err != nil {
+		t.Fatalf("BuildSignedTransaction with resolved request: %v", err)
+	}
+	if signed.TxHash == "" || signed.SignedTx == "" {
+		t.Fatalf("unexpected signed tx: %+v", signed)
+	}
+
+	if _, err := s.CreateUnSignTransaction(ctx, domain.UnSignTransactionParam{Base64Tx: encode("sender.eth", "bob.eth", "usdc.eth")}); errcode.CodeOf(err) != errcode.NotFound {
+		t.Fatalf("unresolved to address: error = %v, want %s", err, errcode.NotFound)
+	}
+}
@@ -0,0 +1,1696 @@
+package evm
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethereumtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/status-im/keycard-go/hexutils"
+	"github.com/web3-fighter/chain-explorer-api/types"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/pkg/util"
+	"github.com/web3-fighter/wallet-chain-account/service"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"github.com/web3-fighter/wallet-chain-account/service/unimplemente"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ service.WalletAccountService = (*EVMNodeService)(nil)
+
+// EVMNodeService 通用 EVM 链实现，链名、链 ID 及差异化开关由 ChainConfig 指定，
+// 以太坊及各 L2 / 侧链共用同一套逻辑。
+type EVMNodeService struct {
+	conf       ChainConfig
+	evmClient  evmbase.EVMClient
+	dataClient *evmbase.EthScan
+	nonces     *nonceManager
+	tokens     *tokenCache
+	unimplemente.UnimplementedService
+}
+
+func (s *EVMNodeService) GetBlockHeaderByNumber(ctx context.Context, param domain.BlockHeaderNumberParam) (domain.BlockHeader, error) {
+	var blockNumber *big.Int
+	if param.Height == 0 {
+		blockNumber = nil // return latest block
+	} else {
+		blockNumber = big.NewInt(param.Height) // return special block by number
+	}
+	blockInfo, err := s.evmClient.BlockHeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		log.Error("get latest block header fail", "err", err)
+		return domain.BlockHeader{}, wrapRpcError(err, "get latest block header fail")
+	}
+
+	return s.toBlockHeader(blockInfo), nil
+}
+
+// ConvertAddress 将传入的十六进制字符串形式的 secp256k1 公钥 转换为 以太坊地址。
+func (s *EVMNodeService) ConvertAddress(_ context.Context, param domain.ConvertAddressParam) (string, error) {
+	// param.PublicKey 支持三种形式：
+	//   压缩公钥 33 字节（66 个字符，0x02 / 0x03 开头）
+	//   未压缩公钥 65 字节（130 个字符，0x04 开头）
+	//   去掉 0x04 前缀的 64 字节 x、y 坐标
+	// 解码失败、长度或前缀不对、点不在曲线上时返回 InvalidArgument 错误。
+	publicKey, err := parsePublicKey(param.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	publicKeyBytes := crypto.FromECDSAPub(publicKey)
+	/*
+				publicKeyBytes[1:]：跳过第一个字节（0x04，表示未压缩公钥）。
+
+				crypto.Keccak256(publicKeyBytes[1:])：对剩下的 64 字节（x、y 坐标）做 Keccak-256 哈希。
+				crypto.Keccak256(publicKey[1:]) 结果 是 32 字节
+				[12:]：取后 20 字节作为以太坊地址（以太坊地址就是公钥哈希的最后 20 字节）。
+
+			0        11     12                                      31
+			|--------|----------------------------------------------|
+			[ 前12字节丢弃 ][     后20字节 => address 字节内容     ]
+
+		[12:] 是 以太坊地址生成规范 的要求 —— 取 Keccak256 哈希的后 20 字节（32 - 20 = 12）。
+		这是 不是随意取的偏移量，而是协议定义的标准。
+	*/
+	addressCommon := common.BytesToAddress(crypto.Keccak256(publicKeyBytes[1:])[12:])
+	return addressCommon.String(), nil
+}
+
+// parsePublicKey 解析压缩（33 字节）、未压缩（65 字节）或不带前缀（64 字节）的 secp256k1 公钥
+func parsePublicKey(publicKey string) (*ecdsa.PublicKey, error) {
+	publicKey = strings.TrimPrefix(strings.TrimSpace(publicKey), "0x")
+	if publicKey == "" {
+		return nil, errcode.New(errcode.InvalidArgument, "public key is empty")
+	}
+	publicKeyBytes, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "decode public key fail")
+	}
+	var pub *ecdsa.PublicKey
+	switch {
+	case len(publicKeyBytes) == 33 && (publicKeyBytes[0] == 0x02 || publicKeyBytes[0] == 0x03):
+		pub, err = crypto.DecompressPubkey(publicKeyBytes)
+	case len(publicKeyBytes) == 65 && publicKeyBytes[0] == 0x04:
+		pub, err = crypto.UnmarshalPubkey(publicKeyBytes)
+	case len(publicKeyBytes) == 64:
+		pub, err = crypto.UnmarshalPubkey(append([]byte{0x04}, publicKeyBytes...))
+	case len(publicKeyBytes) == 33 || len(publicKeyBytes) == 65:
+		return nil, errcode.New(errcode.InvalidArgument, "invalid public key prefix: 0x%02x", publicKeyBytes[0])
+	default:
+		return nil, errcode.New(errcode.InvalidArgument, "invalid public key length: %d", len(publicKeyBytes))
+	}
+	if err != nil {
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "invalid secp256k1 public key")
+	}
+	// UnmarshalPubkey 不拒绝 (0, 0) 等不在曲线上的点
+	if !crypto.S256().IsOnCurve(pub.X, pub.Y) {
+		return nil, errcode.New(errcode.InvalidArgument, "public key is not on secp256k1 curve")
+	}
+	return pub, nil
+}
+
+// ValidAddress 全小写或全大写的地址不带校验和，视为合法但 Checksummed 为 false；
+// 大小写混合的地址必须符合 EIP-55 校验和，否则多半是手误，判为不合法。
+// 传入 ENS 名称时按链上解析结果判断，同时返回名称及解析出的地址
+func (s *EVMNodeService) ValidAddress(ctx context.Context, param domain.ValidAddressParam) (domain.AddressValidation, error) {
+	if evmbase.IsEnsName(param.Address) {
+		address, name, err := s.resolveAddress(ctx, param.Address)
+		switch errcode.CodeOf(err) {
+		case errcode.NotFound, errcode.InvalidArgument, errcode.Unsupported:
+			return domain.AddressValidation{Reason: err.Error()}, nil
+		}
+		if err != nil {
+			return domain.AddressValidation{}, err
+		}
+		return domain.AddressValidation{Valid: true, Address: address.Hex(), Name: name}, nil
+	}
+	//以太坊地址 = 0x + 40位十六进制字符 → 长度必须是 42。
+	//必须以 "0x" 开头，否则格式不合法。
+	if len(param.Address) != 42 || !strings.HasPrefix(param.Address, "0x") {
+		return domain.AddressValidation{Reason: "address must be 0x followed by 40 hex characters"}, nil
+	}
+	//用正则校验 "0x" 后面的部分是否为 40 位合法的十六进制字符（不区分大小写）。
+	hexPart := param.Address[2:]
+	if !regexp.MustCompile("^[0-9a-fA-F]{40}$").MatchString(hexPart) {
+		return domain.AddressValidation{Reason: "address must be 0x followed by 40 hex characters"}, nil
+	}
+	checksumAddress := common.HexToAddress(param.Address).Hex()
+	if hexPart == strings.ToLower(hexPart) || hexPart == strings.ToUpper(hexPart) {
+		return domain.AddressValidation{Valid: true, Address: checksumAddress}, nil
+	}
+	if param.Address != checksumAddress {
+		return domain.AddressValidation{Reason: "invalid EIP-55 checksum"}, nil
+	}
+	return domain.AddressValidation{Valid: true, Checksummed: true, Address: checksumAddress}, nil
+}
+
+func (s *EVMNodeService) GetBlockByNumber(ctx context.Context, param domain.BlockNumberParam) (domain.Block, error) {
+	block, err := s.evmClient.BlockByNumber(ctx, big.NewInt(param.Height))
+	if err != nil {
+		log.Error("block by number error", "err", err)
+		return domain.Block{}, wrapRpcError(err, "block by number error")
+	}
+	blockNumber, _ := block.NumberUint64()
+	var txListRet []*domain.BlockTransaction
+	for _, v := range block.Transactions {
+		txItem := &domain.BlockTransaction{
+			From: v.From,
+			To:   v.To,
+			//TokenAddress:   v.To,
+			//ContractWallet: v.To,
+			Hash:   v.Hash,
+			Height: blockNumber,
+			Amount: v.Value,
+		}
+		txListRet = append(txListRet, txItem)
+	}
+	if s.conf.Features.InternalTransfer {
+		txListRet = append(txListRet, s.traceBlockInternalTransfers(ctx, block, blockNumber)...)
+	}
+	// 区块中只列出原生币转账
+	native := s.nativeTokenInfo()
+	for _, txItem := range txListRet {
+		txItem.Decimals, txItem.Symbol = native.Decimals, native.Symbol
+	}
+	return domain.Block{
+		Height:       int64(blockNumber),
+		Hash:         block.Hash.String(),
+		BaseFee:      block.BaseFee,
+		Transactions: txListRet,
+		BlobBaseFee:  s.blobBaseFee((*uint64)(block.ExcessBlobGas)),
+	}, nil
+}
+
+func (s *EVMNodeService) GetBlockByHash(ctx context.Context, param domain.BlockHashParam) (domain.Block, error) {
+	block, err := s.evmClient.BlockByHash(ctx, common.HexToHash(param.Hash))
+	if err != nil {
+		log.Error("block by hash error", "err", err)
+		return domain.Block{}, wrapRpcError(err, "block by hash error")
+	}
+	blockNumber, _ := block.NumberUint64()
+	var txListRet []*domain.BlockTransaction
+	for _, v := range block.Transactions {
+		txItem := &domain.BlockTransaction{
+			From: v.From,
+			To:   v.To,
+			//TokenAddress:   v.To,
+			//ContractWallet: v.To,
+			Hash:   v.Hash,
+			Amount: v.Value,
+			Height: blockNumber,
+		}
+		txListRet = append(txListRet, txItem)
+	}
+	if s.conf.Features.InternalTransfer {
+		txListRet = append(txListRet, s.traceBlockInternalTransfers(ctx, block, blockNumber)...)
+	}
+	// 区块中只列出原生币转账
+	native := s.nativeTokenInfo()
+	for _, txItem := range txListRet {
+		txItem.Decimals, txItem.Symbol = native.Decimals, native.Symbol
+	}
+	return domain.Block{
+		Height:       int64(blockNumber),
+		Hash:         block.Hash.String(),
+		BaseFee:      block.BaseFee,
+		Transactions: txListRet,
+		BlobBaseFee:  s.blobBaseFee((*uint64)(block.ExcessBlobGas)),
+	}, nil
+}
+
+func (s *EVMNodeService) GetBlockHeaderByHash(ctx context.Context, param domain.BlockHeaderHashParam) (domain.BlockHeader, error) {
+	blockInfo, err := s.evmClient.BlockHeaderByHash(ctx, common.HexToHash(param.Hash))
+	if err != nil {
+		log.Error("get latest block header fail", "err", err)
+		return domain.BlockHeader{}, wrapRpcError(err, "get latest block header fail")
+	}
+	return s.toBlockHeader(blockInfo), nil
+}
+
+func (s *EVMNodeService) ListBlockHeaderByRange(ctx context.Context, param domain.BlockHeaderByRangeParam) ([]domain.BlockHeader, error) {
+	startBlock := new(big.Int)
+	endBlock := new(big.Int)
+	if _, ok := startBlock.SetString(param.Start, 10); !ok {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid start block: %s", param.Start)
+	}
+	if _, ok := endBlock.SetString(param.End, 10); !ok {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid end block: %s", param.End)
+	}
+	if startBlock.Sign() < 0 || startBlock.Cmp(endBlock) > 0 {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid block range: %s-%s", param.Start, param.End)
+	}
+	maxRange := s.conf.MaxHeaderRange
+	if maxRange == 0 {
+		maxRange = defaultMaxHeaderRange
+	}
+	if span := new(big.Int).Sub(endBlock, startBlock); !span.IsUint64() || span.Uint64() >= maxRange {
+		return nil, errcode.New(errcode.InvalidArgument, "block range too large: %s-%s, at most %d headers", param.Start, param.End, maxRange)
+	}
+	blockRange, err := s.evmClient.BlockHeadersByRange(ctx, startBlock, endBlock)
+	if err != nil {
+		log.Error("list block header range fail", "err", err)
+		return nil, wrapRpcError(err, "list block header range fail")
+	}
+	blockHeaderList := make([]domain.BlockHeader, 0, len(blockRange))
+	for i := range blockRange {
+		blockHeaderList = append(blockHeaderList, s.toBlockHeader(&blockRange[i]))
+	}
+	return blockHeaderList, nil
+}
+
+// GetAccount 余额直接从节点读取：ContractAddress 为空时为原生币余额，否则为 ERC20 balanceOf
+func (s *EVMNodeService) GetAccount(ctx context.Context, param domain.AccountParam) (domain.Account, error) {
+	address, name, err := s.resolveAddress(ctx, param.Address)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	// Sequence 为下一笔交易建议使用的 nonce：含交易池中的交易，并跳过本服务已分配、尚未上链的 nonce；
+	// 查询不占用该 nonce，并发构造多笔交易时以 CreateUnSignTransaction 的占用为准，冲突时后构造的覆盖先构造的
+	latest, pending, err := s.accountNonces(ctx, address)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	accountCode, err := s.evmClient.EthGetCode(ctx, address)
+	if err != nil {
+		log.Error("get account code fail", "err", err)
+		return domain.Account{}, wrapRpcError(err, "get account code fail")
+	}
+	balance, err := s.balanceOf(ctx, address, param.ContractAddress)
+	if err != nil {
+		return domain.Account{}, err
+	}
+	sequence := strconv.FormatUint(s.nonces.Next(address, latest, pending), 10)
+	account := domain.Account{
+		Sequence:    sequence,
+		Balance:     balance.String(),
+		AccountType: accountCode.Kind,
+		Address:     address.Hex(),
+		Name:        name,
+	}
+	meta := s.tokenMetas(ctx, []string{param.ContractAddress})[param.ContractAddress]
+	account.Decimals, account.Symbol = meta.Decimals, meta.Symbol
+	if accountCode.Delegate != nil {
+		account.Delegate = accountCode.Delegate.String()
+	}
+	return account, nil
+}
+
+// GetFee 按 eth_feeHistory（legacy 链按近期区块 gasPrice）给出慢 / 中 / 快三档费用，见 feeTiers；
+// 请求中带了 RawTx（与 CreateUnSignTransaction 相同的 base64 交易请求）时，
+// 额外通过 eth_estimateGas 估算 gas 上限，并给出各档位的最高总费用
+func (s *EVMNodeService) GetFee(ctx context.Context, param domain.FeeParam) (domain.Fee, error) {
+	tiers, err := s.feeTiers(ctx)
+	if err != nil {
+		return domain.Fee{}, err
+	}
+	if param.RawTx != "" {
+		gasLimit, err := s.estimateGas(ctx, param)
+		if err != nil {
+			return domain.Fee{}, err
+		}
+		for i := range tiers {
+			// 最高 gas 单价：EIP-1559 为 maxFeePerGas，legacy 为 gasPrice
+			maxPrice := tiers[i].MaxFeePerGas
+			if maxPrice == "" {
+				maxPrice = tiers[i].GasPrice
+			}
+			maxCost, _ := new(big.Int).SetString(maxPrice, 10)
+			tiers[i].GasLimit = gasLimit
+			tiers[i].MaxCost = maxCost.Mul(maxCost, new(big.Int).SetUint64(gasLimit)).String()
+		}
+	}
+	return domain.Fee{
+		SlowFee:   tiers[0],
+		NormalFee: tiers[1],
+		FastFee:   tiers[2],
+	}, nil
+}
+
+// estimateGas 估算 FeeParam.RawTx 所需的 gas 上限并按 GasLimitMargin 放大，发送方优先取 FeeParam.Address
+func (s *EVMNodeService) estimateGas(ctx context.Context, param domain.FeeParam) (uint64, error) {
+	txReq, err := decodeTxRequest(param.RawTx)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.resolveTxAddresses(ctx, txReq); err != nil {
+		return 0, err
+	}
+	from := txReq.FromAddress
+	if param.Address != "" {
+		address, _, err := s.resolveAddress(ctx, param.Address)
+		if err != nil {
+			return 0, err
+		}
+		from = address.Hex()
+	}
+	if !common.IsHexAddress(from) {
+		return 0, errcode.New(errcode.InvalidArgument, "invalid from address: %s", from)
+	}
+	to, value, data, err := s.buildTxCall(txReq)
+	if err != nil {
+		return 0, err
+	}
+	estimated, err := s.evmClient.EstimateGas(ctx, ethereum.CallMsg{
+		From:       common.HexToAddress(from),
+		To:         &to,
+		Value:      value,
+		Data:       data,
+		AccessList: txReq.AccessList,
+	})
+	if err != nil {
+		log.Error("estimate gas failed", "chain", s.conf.ChainName, "err", err)
+		return 0, wrapRpcError(err, "estimate gas failed")
+	}
+	return s.gasWithMargin(estimated), nil
+}
+
+// gasWithMargin eth_estimateGas 的估算值按 GasLimitMargin 放大
+func (s *EVMNodeService) gasWithMargin(estimated uint64) uint64 {
+	margin := s.conf.GasLimitMargin
+	if margin == 0 {
+		margin = defaultGasLimitMargin
+	}
+	return estimated * (100 + margin) / 100
+}
+
+// GetNonceStatus 返回账户的在途 nonce、空洞及卡住的交易，见 nonceManager；地址可以是 ENS 名称
+func (s *EVMNodeService) GetNonceStatus(ctx context.Context, param domain.NonceStatusParam) (domain.NonceStatus, error) {
+	address, name, err := s.resolveAddress(ctx, param.Address)
+	if err != nil {
+		return domain.NonceStatus{}, err
+	}
+	latest, pending, err := s.accountNonces(ctx, address)
+	if err != nil {
+		return domain.NonceStatus{}, err
+	}
+	status := s.nonces.Status(address, latest, pending)
+	status.Address, status.Name = address.Hex(), name
+	return status, nil
+}
+
+// accountNonces 已上链（latest）与含交易池（pending）的 nonce
+func (s *EVMNodeService) accountNonces(ctx context.Context, address common.Address) (uint64, uint64, error) {
+	latest, err := s.evmClient.TxCountByAddress(ctx, address)
+	if err != nil {
+		log.Error("get nonce by address fail", "err", err)
+		return 0, 0, wrapRpcError(err, "get nonce by address fail")
+	}
+	pending, err := s.evmClient.PendingNonceAt(ctx, address)
+	if err != nil {
+		log.Error("get pending nonce by address fail", "err", err)
+		return 0, 0, wrapRpcError(err, "get pending nonce by address fail")
+	}
+	return uint64(latest), max(pending, uint64(latest)), nil
+}
+
+// SendTx param.Simulate 为 true 时先在 pending 状态上预执行，revert 则拒绝广播，见 SimulateTransaction
+func (s *EVMNodeService) SendTx(ctx context.Context, param domain.SendTxParam) (string, error) {
+	if param.Simulate {
+		contractAbi, err := parseCallAbi(param.Abi)
+		if err != nil {
+			return "", err
+		}
+		_, revert, err := s.simulate(ctx, param.RawTx, contractAbi)
+		if err != nil {
+			return "", err
+		}
+		if revert != nil {
+			return "", revertError(revert)
+		}
+	}
+	transaction, err := s.evmClient.SendRawTransaction(ctx, param.RawTx)
+	if err != nil {
+		return "", wrapRpcError(err, "send transaction error")
+	}
+	// 广播成功后记录 nonce 状态，解不出发送方（如非本服务构造的交易类型）时忽略
+	if rawTxBytes, err := decodeRawTx(param.RawTx); err == nil {
+		if isSetCodeTx(rawTxBytes) {
+			if tx, from, err := s.decodeSignedSetCodeTx(rawTxBytes); err == nil {
+				s.nonces.Broadcast(from, tx.Nonce, transaction.String())
+			}
+		} else if tx, from, err := s.decodeSignedTx(rawTxBytes); err == nil {
+			s.nonces.Broadcast(from, tx.Nonce(), transaction.String())
+		}
+	}
+	return transaction.String(), nil
+}
+
+func (s *EVMNodeService) ListTxByAddress(ctx context.Context, param domain.TxAddressParam) ([]domain.TxMessage, error) {
+	if s.dataClient == nil {
+		return nil, s.noExplorerError()
+	}
+	var resp *types.TransactionResponse[types.AccountTxResponse]
+	var err error
+	if param.ContractAddress != "0x00" && param.ContractAddress != "" {
+		resp, err = s.dataClient.GetTxByAddress(uint64(param.Page), uint64(param.PageSize), param.Address, "tokentx")
+	} else {
+		resp, err = s.dataClient.GetTxByAddress(uint64(param.Page), uint64(param.PageSize), param.Address, "txlist")
+	}
+	if err != nil {
+		log.Error("get GetTxByAddress error", "err", err)
+		return nil, errcode.Wrap(errcode.UpstreamUnavailable, err, "get GetTxByAddress error")
+	}
+	txs := resp.TransactionList
+	tokenAddresses := make([]string, len(txs))
+	for i := range txs {
+		tokenAddresses[i] = txs[i].TokenContractAddress
+	}
+	metas := s.tokenMetas(ctx, tokenAddresses)
+	list := make([]domain.TxMessage, 0, len(txs))
+	for i := 0; i < len(txs); i++ {
+		var txStatus domain.TxStatus
+		if txs[i].State == "1" {
+			txStatus = domain.TxStatus_Success
+		} else {
+			txStatus = domain.TxStatus_Failed
+		}
+		list = append(list, domain.TxMessage{
+			Hash:     txs[i].TxId,
+			Tos:      []string{txs[i].To},
+			Froms:    []string{txs[i].From},
+			Fee:      txs[i].TxFee,
+			Status:   txStatus,
+			Values:   []string{txs[i].Amount},
+			Type:     1,
+			Height:   txs[i].Height,
+			Decimals: []uint32{metas[tokenAddresses[i]].Decimals},
+			Symbols:  []string{metas[tokenAddresses[i]].Symbol},
+		})
+	}
+	return list, nil
+}
+
+// GetTxByHash 返回交易内的全部资产转移：顶层原生币转账在前，其后按日志顺序列出
+// ERC20 / ERC721 Transfer 及 ERC1155 TransferSingle / TransferBatch 事件，开启 InternalTransfer 时最后追加合约内部的原生币转账，
+// Froms、Tos、Values、TokenAddresses、TokenIds 按下标对应
+func (s *EVMNodeService) GetTxByHash(ctx context.Context, param domain.GetTxByHashParam) (domain.TxMessage, error) {
+	hash := common.HexToHash(param.Hash)
+	tx, err := s.evmClient.TxByHash(ctx, hash)
+	if errors.Is(err, ethereumtypes.ErrTxTypeNotSupported) {
+		// go-ethereum 当前版本无法解码 type 0x04，改取规范编码自行解码
+		return s.getSetCodeTxByHash(ctx, hash)
+	}
+	if err != nil {
+		log.Error("get transaction error", "err", err)
+		return domain.TxMessage{}, wrapRpcError(err, "get transaction error")
+	}
+	receipt, err := s.evmClient.TxReceiptByHash(ctx, hash)
+	if err != nil {
+		log.Error("get transaction receipt error", "err", err)
+		return domain.TxMessage{}, wrapRpcError(err, "get transaction receipt error")
+	}
+	from, err := ethereumtypes.Sender(ethereumtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Error("recover tx sender fail", "err", err)
+		return domain.TxMessage{}, errcode.Wrap(errcode.SignatureMismatch, err, "recover tx sender fail")
+	}
+
+	txMessage := s.txMessage(ctx, receipt, tx.Type(), from, tx.To(), tx.Value(), tx.Data())
+	txMessage.Fee = txFee(receipt, tx.GasPrice()).String()
+	if tx.Type() == ethereumtypes.BlobTxType {
+		for _, hash := range tx.BlobHashes() {
+			txMessage.BlobVersionedHashes = append(txMessage.BlobVersionedHashes, hash.String())
+		}
+		txMessage.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+		txMessage.BlobGasUsed = receipt.BlobGasUsed
+		if receipt.BlobGasPrice != nil {
+			txMessage.BlobGasPrice = receipt.BlobGasPrice.String()
+			txMessage.BlobFee = new(big.Int).Mul(receipt.BlobGasPrice, new(big.Int).SetUint64(receipt.BlobGasUsed)).String()
+		}
+	}
+	return txMessage, nil
+}
+
+// getSetCodeTxByHash 通过 eth_getRawTransactionByHash 取得 EIP-7702 交易的规范编码，用 evmbase.DecodeSetCodeTx 解码
+func (s *EVMNodeService) getSetCodeTxByHash(ctx context.Context, hash common.Hash) (domain.TxMessage, error) {
+	raw, err := s.evmClient.RawTxByHash(ctx, hash)
+	if err != nil {
+		log.Error("get raw transaction error", "err", err)
+		return domain.TxMessage{}, wrapRpcError(err, "get raw transaction error")
+	}
+	tx, err := evmbase.DecodeSetCodeTx(raw)
+	if err != nil {
+		log.Error("decode set code tx fail", "err", err)
+		return domain.TxMessage{}, errcode.Wrap(errcode.Unsupported, err, "unsupported transaction type")
+	}
+	receipt, err := s.evmClient.TxReceiptByHash(ctx, hash)
+	if err != nil {
+		log.Error("get transaction receipt error", "err", err)
+		return domain.TxMessage{}, wrapRpcError(err, "get transaction receipt error")
+	}
+	from, err := tx.Sender()
+	if err != nil {
+		log.Error("recover tx sender fail", "err", err)
+		return domain.TxMessage{}, errcode.Wrap(errcode.SignatureMismatch, err, "recover tx sender fail")
+	}
+
+	txMessage := s.txMessage(ctx, receipt, evmbase.SetCodeTxType, from, &tx.To, tx.Value, tx.Data)
+	txMessage.Fee = txFee(receipt, tx.GasFeeCap).String()
+	return txMessage, nil
+}
+
+// txMessage 组装交易内的资产转移：顶层原生币转账在前，其后按日志顺序列出代币转移，
+// 开启 InternalTransfer 时最后追加合约内部的原生币转账；Fee 及各类型特有字段由调用方填写
+func (s *EVMNodeService) txMessage(ctx context.Context, receipt *ethereumtypes.Receipt, txType uint8, from common.Address, txTo *common.Address, value *big.Int, data []byte) domain.TxMessage {
+	// 合约创建交易没有 to，以新合约地址作为收款方
+	to := receipt.ContractAddress
+	if txTo != nil {
+		to = *txTo
+	}
+
+	var transfers []evmbase.TokenTransfer
+	if s.conf.Features.TokenTransfer {
+		transfers = evmbase.ParseTransferLogs(receipt.Logs)
+	}
+	if s.conf.Features.InternalTransfer && receipt.Status == ethereumtypes.ReceiptStatusSuccessful {
+		transfers = append(transfers, s.traceInternalTransfers(ctx, receipt.TxHash)...)
+	}
+	// 没有任何代币事件及内部转账时仍返回顶层转账（金额可能为 0），保证结果非空
+	if value.Sign() > 0 || len(transfers) == 0 {
+		transfers = append([]evmbase.TokenTransfer{{
+			From:  from,
+			To:    to,
+			Value: value,
+		}}, transfers...)
+	}
+
+	txMessage := domain.TxMessage{
+		Hash:            receipt.TxHash.Hex(),
+		Index:           uint32(receipt.TransactionIndex),
+		Status:          domain.TxStatus_Failed,
+		Type:            int32(txType),
+		Height:          receipt.BlockNumber.String(),
+		ContractAddress: transfers[0].Token.String(),
+		Data:            hexutils.BytesToHex(data),
+	}
+	if receipt.Status == ethereumtypes.ReceiptStatusSuccessful {
+		txMessage.Status = domain.TxStatus_Success
+	}
+	for _, transfer := range transfers {
+		tokenId := ""
+		if transfer.TokenId != nil {
+			tokenId = transfer.TokenId.String()
+		}
+		txMessage.Froms = append(txMessage.Froms, transfer.From.String())
+		txMessage.Tos = append(txMessage.Tos, transfer.To.String())
+		txMessage.Values = append(txMessage.Values, transfer.Value.String())
+		txMessage.TokenAddresses = append(txMessage.TokenAddresses, transfer.Token.String())
+		txMessage.TokenIds = append(txMessage.TokenIds, tokenId)
+	}
+	metas := s.tokenMetas(ctx, txMessage.TokenAddresses)
+	for _, tokenAddress := range txMessage.TokenAddresses {
+		meta := metas[tokenAddress]
+		txMessage.Decimals = append(txMessage.Decimals, meta.Decimals)
+		txMessage.Symbols = append(txMessage.Symbols, meta.Symbol)
+	}
+	return txMessage
+}
+
+// CreateUnSignTransaction 创建未签名交易（UnSigned Transaction），返回待签名哈希；
+// 地址以 ENS 名称给出时只在这里解析一次，同时返回解析结果及替换为十六进制地址的 Base64Tx，供签名方核对并用于 BuildSignedTransaction
+/*
+	大多数交易已经是 EIP-1559 类型（Type 0x02），比例通常超过 90%。
+	因为主流钱包（如 MetaMask、Rainbow、Safe 等）都默认使用 EIP-1559。
+	BSC 等侧链仍需 legacy（Type 0x00）交易，部分集成需要 access list（Type 0x01），由请求中的 tx_type 指定。
+*/
+func (s *EVMNodeService) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (domain.UnSignTransaction, error) {
+	txReq, err := decodeTxRequest(param.Base64Tx)
+	if err != nil {
+		return domain.UnSignTransaction{}, err
+	}
+	resolved, err := s.resolveTxAddresses(ctx, txReq)
+	if err != nil {
+		return domain.UnSignTransaction{}, err
+	}
+	built, err := s.buildTx(txReq)
+	if err != nil {
+		return domain.UnSignTransaction{}, err
+	}
+
+	// Create unsigned transaction
+	var rawTx string
+	if built.setCode != nil {
+		log.Info("evm CreateUnSignTransaction", "chain", s.conf.ChainName, "txType", built.req.TxType, "txData", util.ToJSONString(built.setCode))
+		rawTx = built.setCode.SigHash().String()
+	} else {
+		log.Info("evm CreateUnSignTransaction", "chain", s.conf.ChainName, "txType", built.req.TxType, "txData", util.ToJSONString(built.txData))
+		rawTx, err = evmbase.CreateUnSignTx(built.txData, s.chainID())
+		if err != nil {
+			log.Error("create un sign tx fail", "err", err)
+			return domain.UnSignTransaction{}, errcode.Wrap(errcode.InvalidArgument, err, "create un sign tx fail")
+		}
+	}
+
+	result := domain.UnSignTransaction{UnSignTx: rawTx}
+	if len(resolved) > 0 {
+		txReqJson, err := json.Marshal(built.req)
+		if err != nil {
+			return domain.UnSignTransaction{}, errcode.Wrap(errcode.Unknown, err, "marshal resolved tx fail")
+		}
+		result.Base64Tx = base64.StdEncoding.EncodeToString(txReqJson)
+		result.ResolvedAddresses = resolved
+	}
+
+	log.Info("evm CreateUnSignTransaction", "chain", s.conf.ChainName, "rawTx", rawTx)
+	s.nonces.Reserve(common.HexToAddress(built.req.FromAddress), built.req.Nonce, rawTx)
+	return result, nil
+}
+
+// CreateUnSignAuthorization 返回 EIP-7702 授权元组的待签名哈希，由授权 EOA 离线签名后放入 set_code 交易的 authorization_list
+func (s *EVMNodeService) CreateUnSignAuthorization(_ context.Context, param domain.UnSignAuthorizationParam) (string, error) {
+	if !s.profile().EIP7702 {
+		return "", errcode.New(errcode.Unsupported, "%s does not support EIP-7702 transactions", s.conf.ChainName)
+	}
+	authJsonByte, err := base64.StdEncoding.DecodeString(param.Base64Authorization)
+	if err != nil {
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "decode base64 authorization fail")
+	}
+	var item Authorization
+	if err := json.Unmarshal(authJsonByte, &item); err != nil {
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "parse authorization json fail")
+	}
+	auth, err := parseAuthorization(item)
+	if err != nil {
+		return "", err
+	}
+	// chain_id 为 0 的授权可在任意链重放，只允许显式使用；其余必须与当前链一致
+	if auth.ChainID.Sign() != 0 && auth.ChainID.Cmp(s.chainID()) != 0 {
+		return "", errcode.New(errcode.InvalidArgument, "authorization chain ID %s does not match %s (%d)", item.ChainId, s.conf.ChainName, s.conf.ChainId)
+	}
+	return auth.SigHash().String(), nil
+}
+
+// BuildSignedTransaction 构造一个 已签名交易，交易类型与 CreateUnSignTransaction 一致，
+func (s *EVMNodeService) BuildSignedTransaction(_ context.Context, param domain.SignedTransactionParam) (domain.SignedTransaction, error) {
+	var result domain.SignedTransaction
+
+	txReq, err := decodeTxRequest(param.Base64Tx)
+	if err != nil {
+		return result, err
+	}
+	// 再次解析 ENS 名称可能得到与待签名哈希不同的地址，只接受 CreateUnSignTransaction 返回的十六进制请求
+	if err := requireHexTxAddresses(txReq); err != nil {
+		return result, err
+	}
+	// 返回：实际参与交易构造的结构体，以及原始请求用于日志或比对
+	built, err := s.buildTx(txReq)
+	if err != nil {
+		log.Error("buildTx failed", "err", err)
+		return result, err
+	}
+	txReq = built.req
+
+	log.Info("evm BuildSignedTransaction", "chain", s.conf.ChainName, "txReq", util.ToJSONString(txReq))
+	log.Info("evm BuildSignedTransaction", "chain", s.conf.ChainName, "req.Signature", param.Signature)
+
+	// Decode signature and create signed transaction
+	inputSignatureByte, err := hex.DecodeString(strings.TrimPrefix(param.Signature, "0x"))
+	if err != nil {
+		log.Error("decode signature failed", "err", err)
+		return result, errcode.Wrap(errcode.InvalidArgument, err, "invalid signature")
+	}
+
+	// 构造已签名交易，并从签名交易中还原出发送者地址
+	sender, rawTx, txHash, err := s.signTx(built, inputSignatureByte)
+	if err != nil {
+		return result, err
+	}
+
+	log.Info("evm BuildSignedTransaction", "chain", s.conf.ChainName, "rawTx", rawTx)
+
+	// Verify sender，校验签名是否由发起者地址签出
+	// 说明签名和from地址不一致，可能是签名错误或数据被篡改
+	if sender != common.HexToAddress(txReq.FromAddress) {
+		log.Error("sender mismatch",
+			"expected", txReq.FromAddress,
+			"got", sender.Hex())
+		return result, errcode.New(errcode.SignatureMismatch, "sender address mismatch: expected %s, got %s", txReq.FromAddress, sender.Hex())
+	}
+
+	log.Info("evm BuildSignedTransaction", "chain", s.conf.ChainName, "sender", sender.Hex())
+
+	// TxHash：交易哈希；
+	// SignedTx：带签名的交易原文（legacy 为 RLP 编码，类型化交易为 type 字节 + RLP 编码）；
+	s.nonces.Signed(sender, txReq.Nonce, txHash)
+	result.TxHash = txHash
+	result.SignedTx = rawTx
+	return result, nil
+}
+
+func (s *EVMNodeService) DecodeTransaction(_ context.Context, param domain.DecodeTransactionParam) (string, error) {
+	rawTxBytes, err := decodeRawTx(param.RawTx)
+	if err != nil {
+		return "", err
+	}
+	contractAbi, err := parseCallAbi(param.Abi)
+	if err != nil {
+		return "", err
+	}
+	if isSetCodeTx(rawTxBytes) {
+		txInfo, err := s.decodeSetCodeTxInfo(rawTxBytes, contractAbi)
+		if err != nil {
+			return "", err
+		}
+		return encodeTxInfo(txInfo)
+	}
+
+	tx, from, err := s.decodeSignedTx(rawTxBytes)
+	if err != nil {
+		return "", err
+	}
+
+	// 构建基础信息
+	txInfo := Eip1559TransactionInfo{
+		Hash:                 tx.Hash().Hex(),
+		FromAddress:          from.Hex(),
+		ToAddress:            "",
+		Value:                tx.Value().String(),
+		GasLimit:             tx.Gas(),
+		MaxFeePerGas:         tx.GasFeeCap().String(),
+		MaxPriorityFeePerGas: tx.GasTipCap().String(),
+		AccessList:           tx.AccessList(),
+		Nonce:                tx.Nonce(),
+		Data:                 fmt.Sprintf("0x%x", tx.Data()),
+		Type:                 tx.Type(),
+		TxType:               txTypeName(tx.Type()),
+		ChainId:              tx.ChainId().String(),
+		Amount:               tx.Value().String(),
+	}
+	if tx.Type() == ethereumtypes.LegacyTxType || tx.Type() == ethereumtypes.AccessListTxType {
+		txInfo.GasPrice = tx.GasPrice().String()
+	}
+	if tx.Type() == ethereumtypes.BlobTxType {
+		for _, hash := range tx.BlobHashes() {
+			txInfo.BlobVersionedHashes = append(txInfo.BlobVersionedHashes, hash.String())
+		}
+		txInfo.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+		txInfo.BlobGas = tx.BlobGas()
+	}
+
+	if tx.To() != nil {
+		txInfo.ToAddress = tx.To().Hex()
+		fillTokenTransfer(&txInfo, *tx.To(), tx.Data())
+		fillCall(&txInfo, contractAbi, tx.Data())
+	}
+	return encodeTxInfo(txInfo)
+}
+
+// decodeSetCodeTxInfo 解码 EIP-7702 交易，授权列表中附带每个授权恢复出的 EOA 地址（签名无效时为空）
+func (s *EVMNodeService) decodeSetCodeTxInfo(rawTxBytes []byte, contractAbi *abi.ABI) (Eip1559TransactionInfo, error) {
+	tx, from, err := s.decodeSignedSetCodeTx(rawTxBytes)
+	if err != nil {
+		return Eip1559TransactionInfo{}, err
+	}
+	txHash, err := tx.Hash()
+	if err != nil {
+		return Eip1559TransactionInfo{}, errcode.Wrap(errcode.InvalidArgument, err, "encode set code tx failed")
+	}
+	txInfo := Eip1559TransactionInfo{
+		Hash:                 txHash.Hex(),
+		FromAddress:          from.Hex(),
+		ToAddress:            tx.To.Hex(),
+		Value:                tx.Value.String(),
+		GasLimit:             tx.Gas,
+		MaxFeePerGas:         tx.GasFeeCap.String(),
+		MaxPriorityFeePerGas: tx.GasTipCap.String(),
+		AccessList:           tx.AccessList,
+		Nonce:                tx.Nonce,
+		Data:                 fmt.Sprintf("0x%x", tx.Data),
+		Type:                 evmbase.SetCodeTxType,
+		TxType:               TxTypeSetCode,
+		ChainId:              tx.ChainID.String(),
+		Amount:               tx.Value.String(),
+	}
+	for _, auth := range tx.AuthList {
+		item := Authorization{
+			ChainId: auth.ChainID.String(),
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+		}
+		if authority, err := auth.Authority(); err == nil {
+			item.Authority = authority.Hex()
+		}
+		txInfo.AuthorizationList = append(txInfo.AuthorizationList, item)
+	}
+	fillTokenTransfer(&txInfo, tx.To, tx.Data)
+	fillCall(&txInfo, contractAbi, tx.Data)
+	return txInfo, nil
+}
+
+// fillTokenTransfer 识别 ERC20 transfer 及 ERC721 / ERC1155 safeTransferFrom，按调用数据填充实际收款地址、金额、代币合约及 TokenId
+func fillTokenTransfer(txInfo *Eip1559TransactionInfo, to common.Address, data []byte) {
+	transfer, ok := evmbase.ParseTransferCall(to, data)
+	if !ok {
+		return
+	}
+	txInfo.ToAddress = transfer.To.String()
+	txInfo.ContractAddress = transfer.Token.String()
+	txInfo.Amount = transfer.Value.String()
+	txInfo.TokenStandard = transfer.Standard
+	if transfer.TokenId != nil {
+		txInfo.TokenId = transfer.TokenId.String()
+	}
+}
+
+// encodeTxInfo JSON 序列化后 Base64 编码
+func encodeTxInfo(txInfo Eip1559TransactionInfo) (string, error) {
+	jsonBytes, err := json.Marshal(txInfo)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal tx info: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(jsonBytes), nil
+}
+
+// VerifySignedTransaction 验证已签名交易（RawTx，hex）的签名；传入 PublicKey（公钥或地址）时还要求签名者与之一致。
+// 签名无效或签名者不符返回 false，交易无法解码返回 InvalidArgument
+func (s *EVMNodeService) VerifySignedTransaction(ctx context.Context, param domain.VerifyTransactionParam) (bool, error) {
+	rawTxBytes, err := decodeRawTx(param.RawTx)
+	if err != nil {
+		return false, err
+	}
+	var from common.Address
+	if isSetCodeTx(rawTxBytes) {
+		_, from, err = s.decodeSignedSetCodeTx(rawTxBytes)
+	} else {
+		_, from, err = s.decodeSignedTx(rawTxBytes)
+	}
+	if err != nil {
+		if errcode.CodeOf(err) == errcode.SignatureMismatch {
+			log.Info("invalid signature", "err", err)
+			return false, nil
+		}
+		return false, err
+	}
+	if param.PublicKey == "" {
+		return true, nil
+	}
+	expected := param.PublicKey
+	if !common.IsHexAddress(expected) {
+		if expected, err = s.ConvertAddress(ctx, domain.ConvertAddressParam{PublicKey: param.PublicKey}); err != nil {
+			return false, err
+		}
+	}
+	if from != common.HexToAddress(expected) {
+		log.Info("signer mismatch", "expected", expected, "got", from.Hex())
+		return false, nil
+	}
+	return true, nil
+}
+
+// decodeRawTx 解码 hex 编码的原始交易数据（raw_tx）
+func decodeRawTx(rawTx string) ([]byte, error) {
+	rawTxBytes, err := hex.DecodeString(strings.TrimPrefix(rawTx, "0x"))
+	if err != nil {
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "decode raw tx hex failed")
+	}
+	return rawTxBytes, nil
+}
+
+// isSetCodeTx go-ethereum 当前版本无法解码 type 0x04，需走 evmbase.DecodeSetCodeTx
+func isSetCodeTx(rawTxBytes []byte) bool {
+	return len(rawTxBytes) > 0 && rawTxBytes[0] == evmbase.SetCodeTxType
+}
+
+// decodeSignedTx 解码已签名交易（legacy RLP 或类型化交易），校验链 ID 并恢复发送方
+func (s *EVMNodeService) decodeSignedTx(rawTxBytes []byte) (*ethereumtypes.Transaction, common.Address, error) {
+	// UnmarshalBinary 同时支持 legacy 的 RLP 列表和 type 字节开头的类型化交易
+	var tx ethereumtypes.Transaction
+	if err := tx.UnmarshalBinary(rawTxBytes); err != nil {
+		return nil, common.Address{}, errcode.Wrap(errcode.InvalidArgument, err, "decode transaction failed")
+	}
+	// 未按 EIP-155 保护的 legacy 交易 chainId 为 0，不做校验
+	if tx.Protected() && tx.ChainId().Cmp(s.chainID()) != 0 {
+		return nil, common.Address{}, errcode.New(errcode.InvalidArgument, "chain ID %s does not match %s (%d)", tx.ChainId(), s.conf.ChainName, s.conf.ChainId)
+	}
+
+	// 获取交易发送方地址
+	from, err := ethereumtypes.Sender(ethereumtypes.LatestSignerForChainID(s.chainID()), &tx)
+	if err != nil {
+		return nil, common.Address{}, errcode.Wrap(errcode.SignatureMismatch, err, "failed to recover sender")
+	}
+	return &tx, from, nil
+}
+
+// decodeSignedSetCodeTx 解码已签名的 EIP-7702 交易，校验链 ID 并恢复发送方
+func (s *EVMNodeService) decodeSignedSetCodeTx(rawTxBytes []byte) (*evmbase.SetCodeTx, common.Address, error) {
+	tx, err := evmbase.DecodeSetCodeTx(rawTxBytes)
+	if err != nil {
+		return nil, common.Address{}, errcode.Wrap(errcode.InvalidArgument, err, "decode set code transaction failed")
+	}
+	if tx.ChainID == nil || tx.ChainID.Cmp(s.chainID()) != 0 {
+		return nil, common.Address{}, errcode.New(errcode.InvalidArgument, "chain ID %s does not match %s (%d)", tx.ChainID, s.conf.ChainName, s.conf.ChainId)
+	}
+	from, err := tx.Sender()
+	if err != nil {
+		return nil, common.Address{}, errcode.Wrap(errcode.SignatureMismatch, err, "failed to recover sender")
+	}
+	return tx, from, nil
+}
+
+// GetChainCapabilities 未列出的方法由 UnimplementedService 返回 Unimplemented
+func (s *EVMNodeService) GetChainCapabilities(_ context.Context, param domain.ChainCapabilitiesParam) ([]domain.ChainCapability, error) {
+	methods := []string{
+		domain.MethodConvertAddress,
+		domain.MethodValidAddress,
+		domain.MethodGetBlockByNumber,
+		domain.MethodGetBlockByHash,
+		domain.MethodGetBlockHeaderByHash,
+		domain.MethodGetBlockHeaderByNumber,
+		domain.MethodListBlockHeaderByRange,
+		domain.MethodGetAccount,
+		domain.MethodGetBalances,
+		domain.MethodGetTokenInfo,
+		domain.MethodGetNonceStatus,
+		domain.MethodGetFee,
+		domain.MethodSendTx,
+		domain.MethodSimulateTransaction,
+		domain.MethodListTxByAddress,
+		domain.MethodGetTxByHash,
+		domain.MethodCreateUnSignTransaction,
+		domain.MethodCreateReplacementTransaction,
+		domain.MethodBuildSignedTransaction,
+		domain.MethodDecodeTransaction,
+		domain.MethodVerifySignedTransaction,
+	}
+	if s.profile().EIP7702 {
+		methods = append(methods, domain.MethodCreateUnSignAuthorization)
+	}
+	if s.profile().EnsRegistry != (common.Address{}) {
+		methods = append(methods, domain.MethodResolveEnsName, domain.MethodLookupEnsName)
+	}
+	return []domain.ChainCapability{{
+		Chain:   s.conf.ChainName,
+		Network: param.Network,
+		Methods: methods,
+		TxKinds: s.txKinds(),
+	}}, nil
+}
+
+// traceInternalTransfers 追踪失败（如节点临时关闭 debug 接口）不影响交易本身的查询，只记录日志
+func (s *EVMNodeService) traceInternalTransfers(ctx context.Context, hash common.Hash) []evmbase.TokenTransfer {
+	frame, err := s.evmClient.TraceTransaction(ctx, hash)
+	if err != nil {
+		log.Warn("trace transaction fail, skip internal transfers", "chain", s.conf.ChainName, "hash", hash, "err", err)
+		return nil
+	}
+	var transfers []evmbase.TokenTransfer
+	for _, internal := range evmbase.InternalTransfers(frame) {
+		transfers = append(transfers, evmbase.TokenTransfer{
+			From:  internal.From,
+			To:    internal.To,
+			Value: internal.Value,
+		})
+	}
+	return transfers
+}
+
+// traceBlockInternalTransfers 区块内合约内部的原生币转账，ContractWallet 为交易直接调用的合约
+func (s *EVMNodeService) traceBlockInternalTransfers(ctx context.Context, block *evmbase.RpcBlock, blockNumber uint64) []*domain.BlockTransaction {
+	results, err := s.evmClient.TraceBlockByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		log.Warn("trace block fail, skip internal transfers", "chain", s.conf.ChainName, "height", blockNumber, "err", err)
+		return nil
+	}
+	var txList []*domain.BlockTransaction
+	for i, result := range results {
+		if result.Result == nil || result.Result.To == nil {
+			continue
+		}
+		hash := result.TxHash.String()
+		if result.TxHash == (common.Hash{}) && i < len(block.Transactions) {
+			hash = block.Transactions[i].Hash
+		}
+		for _, internal := range evmbase.InternalTransfers(result.Result) {
+			txList = append(txList, &domain.BlockTransaction{
+				From:           internal.From.String(),
+				To:             internal.To.String(),
+				TokenAddress:   common.Address{}.String(),
+				ContractWallet: result.Result.To.String(),
+				Hash:           hash,
+				Height:         blockNumber,
+				Amount:         internal.Value.String(),
+			})
+		}
+	}
+	return txList
+}
+
+// profile 当前链的 ChainProfile，运行期可被配置覆盖，因此每次查询
+func (s *EVMNodeService) profile() evmbase.ChainProfile {
+	return evmbase.ProfileOf(s.conf.ChainId)
+}
+
+// toBlockHeader 不支持 EIP-1559 / EIP-4844 或早于对应升级的区块，相关字段为空，不能直接解引用
+func (s *EVMNodeService) toBlockHeader(header *ethereumtypes.Header) domain.BlockHeader {
+	blockHeader := domain.BlockHeader{
+		Hash:             header.Hash().String(),
+		ParentHash:       header.ParentHash.String(),
+		UncleHash:        header.UncleHash.String(),
+		CoinBase:         header.Coinbase.String(),
+		Root:             header.Root.String(),
+		TxHash:           header.TxHash.String(),
+		ReceiptHash:      header.ReceiptHash.String(),
+		ParentBeaconRoot: common.Hash{}.String(),
+		Difficulty:       header.Difficulty.String(),
+		Number:           header.Number.String(),
+		GasLimit:         header.GasLimit,
+		GasUsed:          header.GasUsed,
+		Time:             header.Time,
+		Extra:            hex.EncodeToString(header.Extra),
+		MixDigest:        header.MixDigest.String(),
+		Nonce:            strconv.FormatUint(header.Nonce.Uint64(), 10),
+		WithdrawalsHash:  common.Hash{}.String(),
+	}
+	profile := s.profile()
+	if profile.EIP1559 && header.BaseFee != nil {
+		blockHeader.BaseFee = header.BaseFee.String()
+	}
+	if header.WithdrawalsHash != nil {
+		blockHeader.WithdrawalsHash = header.WithdrawalsHash.String()
+	}
+	if header.ParentBeaconRoot != nil {
+		blockHeader.ParentBeaconRoot = header.ParentBeaconRoot.String()
+	}
+	if profile.EIP4844 && header.BlobGasUsed != nil && header.ExcessBlobGas != nil {
+		blockHeader.BlobGasUsed = *header.BlobGasUsed
+		blockHeader.ExcessBlobGas = *header.ExcessBlobGas
+		blockHeader.BlobBaseFee = s.blobBaseFee(header.ExcessBlobGas)
+	}
+	return blockHeader
+}
+
+// blobBaseFee 按 EIP-4844 由 excessBlobGas 计算区块的 blob 基础费用，链不支持或区块早于 Cancun 时为空
+func (s *EVMNodeService) blobBaseFee(excessBlobGas *uint64) string {
+	if !s.profile().EIP4844 || excessBlobGas == nil {
+		return ""
+	}
+	return eip4844.CalcBlobFee(*excessBlobGas).String()
+}
+
+// txKinds 按 Features 返回可构造的交易种类
+func (s *EVMNodeService) txKinds() []string {
+	kinds := []string{domain.TxKindNative}
+	if s.conf.Features.TokenTransfer {
+		kinds = append(kinds, domain.TxKindToken)
+	}
+	if s.conf.Features.NFTTransfer {
+		kinds = append(kinds, domain.TxKindNFT)
+	}
+	if s.conf.Features.ContractCall {
+		kinds = append(kinds, domain.TxKindContractCall)
+	}
+	return kinds
+}
+
+// noExplorerError 未配置区块浏览器 API 时，依赖浏览器的方法返回 Unsupported
+func (s *EVMNodeService) noExplorerError() error {
+	return errcode.New(errcode.Unsupported, "%s explorer api not configured", s.conf.ChainName)
+}
+
+// buildTokenTransferData 按 token_standard 生成代币转账的调用数据，NFT 的 from 参数即交易发送方
+func (s *EVMNodeService) buildTokenTransferData(txReq *Eip1559DynamicFeeTx, toAddress common.Address) ([]byte, error) {
+	standard := strings.ToUpper(txReq.TokenStandard)
+	switch standard {
+	case "", evmbase.TokenStandardERC20:
+		if !s.conf.Features.TokenTransfer {
+			return nil, errcode.New(errcode.Unsupported, "%s does not support token transfer", s.conf.ChainName)
+		}
+		amount, err := parseBigInt("amount", txReq.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return evmbase.BuildErc20Data(toAddress, amount), nil
+	case evmbase.TokenStandardERC721, evmbase.TokenStandardERC1155:
+		if !s.conf.Features.NFTTransfer {
+			return nil, errcode.New(errcode.Unsupported, "%s does not support nft transfer", s.conf.ChainName)
+		}
+		// safeTransferFrom 的 from 为 NFT 持有人，编码为零地址时交易签名后在链上 revert
+		fromAddress, err := parseAddress("from address", txReq.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+		if txReq.TokenId == "" {
+			return nil, errcode.New(errcode.InvalidArgument, "token ID is required for %s transfer", standard)
+		}
+		tokenId, err := parseBigInt("token ID", txReq.TokenId)
+		if err != nil {
+			return nil, err
+		}
+		if tokenId.Sign() < 0 {
+			return nil, errcode.New(errcode.InvalidArgument, "invalid token ID: %s", txReq.TokenId)
+		}
+		if standard == evmbase.TokenStandardERC721 {
+			return evmbase.BuildErc721Data(fromAddress, toAddress, tokenId), nil
+		}
+		amount, err := parseBigInt("amount", txReq.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if amount.Sign() <= 0 {
+			return nil, errcode.New(errcode.InvalidArgument, "%s transfer amount must be positive: %s", standard, txReq.Amount)
+		}
+		return evmbase.BuildErc1155Data(fromAddress, toAddress, tokenId, amount, nil), nil
+	default:
+		return nil, errcode.New(errcode.InvalidArgument, "unknown token standard: %s", txReq.TokenStandard)
+	}
+}
+
+// buildContractCallData 按 ABI 编码合约调用，或校验并使用请求中的原始调用数据
+func (s *EVMNodeService) buildContractCallData(txReq *Eip1559DynamicFeeTx) ([]byte, error) {
+	if !s.conf.Features.ContractCall {
+		return nil, errcode.New(errcode.Unsupported, "%s does not support contract call", s.conf.ChainName)
+	}
+	if txReq.Method != "" && txReq.CallData != "" {
+		return nil, errcode.New(errcode.InvalidArgument, "method and call data are mutually exclusive")
+	}
+	var contractAbi *abi.ABI
+	if len(txReq.Abi) > 0 {
+		parsed, err := parseCallAbi(abiFragment(txReq.Abi))
+		if err != nil {
+			return nil, err
+		}
+		contractAbi = parsed
+	}
+	if txReq.CallData != "" {
+		data, err := hexutil.Decode(txReq.CallData)
+		if err != nil || len(data) < 4 {
+			return nil, errcode.New(errcode.InvalidArgument, "invalid call data: %s", txReq.CallData)
+		}
+		if contractAbi != nil {
+			if _, err := evmbase.DecodeCall(*contractAbi, data); err != nil {
+				return nil, errcode.Wrap(errcode.InvalidArgument, err, "call data does not match abi")
+			}
+		}
+		return data, nil
+	}
+	if contractAbi == nil {
+		return nil, errcode.New(errcode.InvalidArgument, "abi is required for method %s", txReq.Method)
+	}
+	data, err := evmbase.PackCall(*contractAbi, txReq.Method, txReq.Args)
+	if err != nil {
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "encode contract call fail")
+	}
+	return data, nil
+}
+
+// parseCallAbi 空字符串返回 nil
+func parseCallAbi(fragment string) (*abi.ABI, error) {
+	if strings.TrimSpace(fragment) == "" {
+		return nil, nil
+	}
+	parsed, err := evmbase.ParseABI(fragment)
+	if err != nil {
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "invalid abi")
+	}
+	return &parsed, nil
+}
+
+// abiFragment 请求中的 abi 既可以直接是 JSON，也可以是包含 JSON 的字符串
+func abiFragment(raw json.RawMessage) string {
+	var fragment string
+	if err := json.Unmarshal(raw, &fragment); err == nil {
+		return fragment
+	}
+	return string(raw)
+}
+
+// fillCall 优先按调用方给出的 ABI 解码，解不出时再按常见代币方法解码
+func fillCall(txInfo *Eip1559TransactionInfo, contractAbi *abi.ABI, data []byte) {
+	if contractAbi != nil {
+		if call, err := evmbase.DecodeCall(*contractAbi, data); err == nil {
+			txInfo.Call = call
+			return
+		}
+	}
+	if call, err := evmbase.DecodeCall(evmbase.CommonCallABI, data); err == nil {
+		txInfo.Call = call
+	}
+}
+
+// signTx 组装已签名交易并恢复发送方，返回发送方、规范编码（hex）及交易哈希
+func (s *EVMNodeService) signTx(built builtTx, signature []byte) (common.Address, string, string, error) {
+	if built.setCode != nil {
+		signedTx, rawTx, txHash, err := evmbase.CreateSignedSetCodeTx(built.setCode, signature)
+		if err != nil {
+			log.Error("create signed tx fail", "err", err)
+			return common.Address{}, "", "", errcode.Wrap(errcode.SignatureMismatch, err, "create signed tx fail")
+		}
+		sender, err := signedTx.Sender()
+		if err != nil {
+			log.Error("recover sender failed", "err", err)
+			return common.Address{}, "", "", errcode.Wrap(errcode.SignatureMismatch, err, "recover sender failed")
+		}
+		return sender, rawTx, txHash, nil
+	}
+	signer, signedTx, rawTx, txHash, err := evmbase.CreateSignedTx(built.txData, signature, s.chainID())
+	if err != nil {
+		log.Error("create signed tx fail", "err", err)
+		return common.Address{}, "", "", errcode.Wrap(errcode.SignatureMismatch, err, "create signed tx fail")
+	}
+	sender, err := ethereumtypes.Sender(signer, signedTx)
+	if err != nil {
+		log.Error("recover sender failed", "err", err)
+		return common.Address{}, "", "", errcode.Wrap(errcode.SignatureMismatch, err, "recover sender failed")
+	}
+	return sender, rawTx, txHash, nil
+}
+
+// builtTx buildTx 的结果：go-ethereum 内置类型放在 txData，EIP-7702 交易放在 setCode，二者只有一个不为空
+type builtTx struct {
+	req     *Eip1559DynamicFeeTx
+	txData  ethereumtypes.TxData
+	setCode *evmbase.SetCodeTx
+}
+
+// buildTx 按请求中的 tx_type 构建 LegacyTx / AccessListTx / DynamicFeeTx / SetCodeTx 的公共方法，请求中的地址须已是十六进制
+func (s *EVMNodeService) buildTx(txReq *Eip1559DynamicFeeTx) (builtTx, error) {
+	txType, err := s.resolveTxType(txReq.TxType)
+	if err != nil {
+		return builtTx{}, err
+	}
+	txReq.TxType = txType
+
+	// 3. Convert string values to big.Int
+	// 将字符串类型的 ChainID、Gas 价格、金额转为 *big.Int，因为以太坊交易结构体中的这些字段是大整数（单位为 wei）
+	chainID, err := parseBigInt("chain ID", txReq.ChainId)
+	if err != nil {
+		return builtTx{}, err
+	}
+	if !chainID.IsUint64() || chainID.Uint64() != s.conf.ChainId {
+		return builtTx{}, errcode.New(errcode.InvalidArgument, "chain ID %s does not match %s (%d)", txReq.ChainId, s.conf.ChainName, s.conf.ChainId)
+	}
+	if _, err := parseAddress("from address", txReq.FromAddress); err != nil {
+		return builtTx{}, err
+	}
+	finalToAddress, finalAmount, buildData, err := s.buildTxCall(txReq)
+	if err != nil {
+		return builtTx{}, err
+	}
+
+	// 6. Create transaction of the requested type
+	switch txType {
+	case TxTypeDynamicFee:
+		// MaxPriorityFeePerGas（小费）	你愿意额外付给矿工的小费（tip）	激励矿工打包你的交易	矿工（打包者）
+		maxPriorityFeePerGas, err := parseBigInt("max priority fee", txReq.MaxPriorityFeePerGas)
+		if err != nil {
+			return builtTx{}, err
+		}
+		// MaxFeePerGas（你能承受的最高费用）	你愿意支付的最多的总费用（含 baseFee 和小费）	限制你最多愿意为 gas 花多少钱	baseFee + 小费（MaxPriorityFeePerGas）总和
+		maxFeePerGas, err := parseBigInt("max fee", txReq.MaxFeePerGas)
+		if err != nil {
+			return builtTx{}, err
+		}
+		return builtTx{req: txReq, txData: &ethereumtypes.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      txReq.Nonce,
+			GasTipCap:  maxPriorityFeePerGas,
+			GasFeeCap:  maxFeePerGas,
+			Gas:        txReq.GasLimit,
+			To:         &finalToAddress,
+			Value:      finalAmount,
+			Data:       buildData,
+			AccessList: txReq.AccessList,
+		}}, nil
+	case TxTypeAccessList:
+		gasPrice, err := parseBigInt("gas price", txReq.GasPrice)
+		if err != nil {
+			return builtTx{}, err
+		}
+		return builtTx{req: txReq, txData: &ethereumtypes.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      txReq.Nonce,
+			GasPrice:   gasPrice,
+			Gas:        txReq.GasLimit,
+			To:         &finalToAddress,
+			Value:      finalAmount,
+			Data:       buildData,
+			AccessList: txReq.AccessList,
+		}}, nil
+	case TxTypeSetCode:
+		setCodeTx, err := s.buildSetCodeTx(txReq, chainID, finalToAddress, finalAmount, buildData)
+		if err != nil {
+			return builtTx{}, err
+		}
+		return builtTx{req: txReq, setCode: setCodeTx}, nil
+	default:
+		if len(txReq.AccessList) > 0 {
+			return builtTx{}, errcode.New(errcode.InvalidArgument, "legacy transaction does not support access list")
+		}
+		gasPrice, err := parseBigInt("gas price", txReq.GasPrice)
+		if err != nil {
+			return builtTx{}, err
+		}
+		// LegacyTx 没有 chainId 字段，由签名器按 EIP-155 写入签名
+		return builtTx{req: txReq, txData: &ethereumtypes.LegacyTx{
+			Nonce:    txReq.Nonce,
+			GasPrice: gasPrice,
+			Gas:      txReq.GasLimit,
+			To:       &finalToAddress,
+			Value:    finalAmount,
+			Data:     buildData,
+		}}, nil
+	}
+}
+
+// decodeTxRequest 解析 base64 编码的 JSON 交易请求
+func decodeTxRequest(base64Tx string) (*Eip1559DynamicFeeTx, error) {
+	// 1. Decode base64 string
+	// 把交易请求先 base64 编码后传过来，这里先进行解码成 JSON 字节
+	txReqJsonByte, err := base64.StdEncoding.DecodeString(base64Tx)
+	if err != nil {
+		log.Error("decode string fail", "err", err)
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "decode base64 tx fail")
+	}
+	// 2. Unmarshal JSON to struct
+	// 反序列化 JSON 为结构体 Eip1559DynamicFeeTx
+	var txReq Eip1559DynamicFeeTx
+	if err := json.Unmarshal(txReqJsonByte, &txReq); err != nil {
+		log.Error("parse json fail", "err", err)
+		return nil, errcode.Wrap(errcode.InvalidArgument, err, "parse tx json fail")
+	}
+	return &txReq, nil
+}
+
+// txAddressField 交易请求中可以填 ENS 名称的地址字段，name 为请求 JSON 中的字段名
+type txAddressField struct {
+	name  string
+	value *string
+}
+
+func txAddressFields(txReq *Eip1559DynamicFeeTx) []txAddressField {
+	return []txAddressField{
+		{name: "from_address", value: &txReq.FromAddress},
+		{name: "to_address", value: &txReq.ToAddress},
+		{name: "contract_address", value: &txReq.ContractAddress},
+	}
+}
+
+// resolveTxAddresses 请求中以 ENS 名称给出的地址解析后以十六进制写回请求，返回每个名称的解析结果；
+// 之后的调用数据编码、nonce 预留都使用解析出的地址，十六进制地址留给后续按字段校验
+func (s *EVMNodeService) resolveTxAddresses(ctx context.Context, txReq *Eip1559DynamicFeeTx) ([]domain.ResolvedAddress, error) {
+	var resolved []domain.ResolvedAddress
+	for _, field := range txAddressFields(txReq) {
+		if !evmbase.IsEnsName(*field.value) {
+			continue
+		}
+		address, name, err := s.resolveAddress(ctx, *field.value)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("evm resolve tx address", "chain", s.conf.ChainName, "field", field.name, "name", name, "address", address)
+		*field.value = address.Hex()
+		resolved = append(resolved, domain.ResolvedAddress{Field: field.name, Name: name, Address: address.Hex()})
+	}
+	return resolved, nil
+}
+
+// requireHexTxAddresses 签名阶段不再解析 ENS 名称，请求中仍有名称时返回 InvalidArgument
+func requireHexTxAddresses(txReq *Eip1559DynamicFeeTx) error {
+	for _, field := range txAddressFields(txReq) {
+		if evmbase.IsEnsName(*field.value) {
+			return errcode.New(errcode.InvalidArgument, "%s %s is an ens name, use the base64_tx returned by CreateUnSignTransaction", field.name, *field.value)
+		}
+	}
+	return nil
+}
+
+// buildTxCall 按请求确定交易实际的 to、value 与 data：原生币转账、代币转账或任意合约调用
+func (s *EVMNodeService) buildTxCall(txReq *Eip1559DynamicFeeTx) (common.Address, *big.Int, []byte, error) {
+	// 4. Handle addresses and data
+	var finalToAddress common.Address
+	var finalAmount *big.Int
+	var buildData []byte
+	// 判断是否是 ETH 转账
+	isEthTrans := isEthTransfer(txReq)
+	log.Info("contract address check", "contractAddress", txReq.ContractAddress, "isEthTransfer", isEthTrans)
+
+	// 5. Handle contract interaction vs direct transfer
+	if txReq.RawData != "" {
+		// 原样重放的 data，To 与 Value 取请求中的值
+		if !isEthTrans || isContractCall(txReq) {
+			return common.Address{}, nil, nil, errcode.New(errcode.InvalidArgument, "raw data can not be used with contract address or contract call")
+		}
+		toAddress, err := parseAddress("to address", txReq.ToAddress)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		amount, err := parseBigInt("amount", txReq.Amount)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		data, err := hexutil.Decode(txReq.RawData)
+		if err != nil {
+			return common.Address{}, nil, nil, errcode.Wrap(errcode.InvalidArgument, err, "invalid raw data: %s", txReq.RawData)
+		}
+		finalToAddress, finalAmount, buildData = toAddress, amount, data
+	} else if isContractCall(txReq) {
+		/*
+			如果是任意合约调用
+			To 是合约地址；
+			Data 按 ABI 编码，或直接使用请求中的调用数据，均在计算待签名哈希前完成校验；
+			Value 为附带的原生币，可为 0。
+		*/
+		if isEthTrans {
+			return common.Address{}, nil, nil, errcode.New(errcode.InvalidArgument, "contract call requires contract address")
+		}
+		var err error
+		if finalToAddress, err = parseAddress("contract address", txReq.ContractAddress); err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		buildData, err = s.buildContractCallData(txReq)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		finalAmount = big.NewInt(0)
+		if txReq.Amount != "" {
+			if finalAmount, err = parseBigInt("amount", txReq.Amount); err != nil {
+				return common.Address{}, nil, nil, err
+			}
+		}
+	} else if isEthTrans {
+		/*
+			如果是 ETH 转账
+			To 是目标地址
+			Value 是金额
+			Data 为空
+		*/
+		toAddress, err := parseAddress("to address", txReq.ToAddress)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		amount, err := parseBigInt("amount", txReq.Amount)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		finalToAddress = toAddress
+		finalAmount = amount
+	} else {
+		/*
+			如果是代币转账
+			实际发送的目标地址是代币合约地址；
+			Data 是调用 ERC20 transfer 或 ERC721 / ERC1155 safeTransferFrom 生成的 ABI 编码；
+			Value = 0，因为你不是转 ETH，只是合约调用。
+		*/
+		toAddress, err := parseAddress("to address", txReq.ToAddress)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		if finalToAddress, err = parseAddress("contract address", txReq.ContractAddress); err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		buildData, err = s.buildTokenTransferData(txReq, toAddress)
+		if err != nil {
+			return common.Address{}, nil, nil, err
+		}
+		finalAmount = big.NewInt(0)
+	}
+	return finalToAddress, finalAmount, buildData, nil
+}
+
+// buildSetCodeTx 构建 EIP-7702 交易，授权列表不能为空且每个授权都须已签名
+func (s *EVMNodeService) buildSetCodeTx(txReq *Eip1559DynamicFeeTx, chainID *big.Int, to common.Address, value *big.Int, data []byte) (*evmbase.SetCodeTx, error) {
+	maxPriorityFeePerGas, err := parseBigInt("max priority fee", txReq.MaxPriorityFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	maxFeePerGas, err := parseBigInt("max fee", txReq.MaxFeePerGas)
+	if err != nil {
+		return nil, err
+	}
+	if len(txReq.AuthorizationList) == 0 {
+		return nil, errcode.New(errcode.InvalidArgument, "set code transaction requires a non-empty authorization list")
+	}
+	authList := make([]evmbase.SetCodeAuthorization, 0, len(txReq.AuthorizationList))
+	for i, item := range txReq.AuthorizationList {
+		auth, err := parseAuthorization(item)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := hex.DecodeString(strings.TrimPrefix(item.Signature, "0x"))
+		if err != nil {
+			return nil, errcode.Wrap(errcode.InvalidArgument, err, "invalid authorization %d signature", i)
+		}
+		if err := auth.WithSignature(signature); err != nil {
+			return nil, errcode.Wrap(errcode.InvalidArgument, err, "invalid authorization %d signature", i)
+		}
+		authList = append(authList, *auth)
+	}
+	return &evmbase.SetCodeTx{
+		ChainID:    chainID,
+		Nonce:      txReq.Nonce,
+		GasTipCap:  maxPriorityFeePerGas,
+		GasFeeCap:  maxFeePerGas,
+		Gas:        txReq.GasLimit,
+		To:         to,
+		Value:      value,
+		Data:       data,
+		AccessList: txReq.AccessList,
+		AuthList:   authList,
+	}, nil
+}
+
+// parseAuthorization 解析授权元组（不含签名）
+func parseAuthorization(item Authorization) (*evmbase.SetCodeAuthorization, error) {
+	chainID, err := parseBigInt("authorization chain ID", item.ChainId)
+	if err != nil {
+		return nil, err
+	}
+	if !common.IsHexAddress(item.Address) {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid authorization address: %s", item.Address)
+	}
+	return &evmbase.SetCodeAuthorization{
+		ChainID: chainID,
+		Address: common.HexToAddress(item.Address),
+		Nonce:   item.Nonce,
+	}, nil
+}
+
+// resolveTxType 未指定类型时按链是否支持 EIP-1559 选择默认类型
+func (s *EVMNodeService) resolveTxType(txType string) (string, error) {
+	switch txType {
+	case "":
+		if s.profile().EIP1559 {
+			return TxTypeDynamicFee, nil
+		}
+		return TxTypeLegacy, nil
+	case TxTypeDynamicFee:
+		if !s.profile().EIP1559 {
+			return "", errcode.New(errcode.Unsupported, "%s does not support EIP-1559 transactions", s.conf.ChainName)
+		}
+		return txType, nil
+	case TxTypeLegacy, TxTypeAccessList:
+		return txType, nil
+	case TxTypeBlob:
+		return "", errcode.New(errcode.Unsupported, "building blob transactions is not supported")
+	case TxTypeSetCode:
+		if !s.profile().EIP7702 {
+			return "", errcode.New(errcode.Unsupported, "%s does not support EIP-7702 transactions", s.conf.ChainName)
+		}
+		return txType, nil
+	default:
+		return "", errcode.New(errcode.InvalidArgument, "unknown tx type: %s", txType)
+	}
+}
+
+// chainID 签名器使用的链 ID，LegacyTx 本身不带 chainId，统一取自链配置
+func (s *EVMNodeService) chainID() *big.Int {
+	return new(big.Int).SetUint64(s.conf.ChainId)
+}
+
+// txTypeName 交易类型编号对应的 tx_type 名称
+func txTypeName(txType uint8) string {
+	switch txType {
+	case ethereumtypes.LegacyTxType:
+		return TxTypeLegacy
+	case ethereumtypes.AccessListTxType:
+		return TxTypeAccessList
+	case ethereumtypes.DynamicFeeTxType:
+		return TxTypeDynamicFee
+	case ethereumtypes.BlobTxType:
+		return TxTypeBlob
+	case evmbase.SetCodeTxType:
+		return TxTypeSetCode
+	default:
+		return strconv.Itoa(int(txType))
+	}
+}
+
+// parseAddress HexToAddress 不会报错，非法输入会得到零地址或截断的地址，须先校验
+func parseAddress(name, value string) (common.Address, error) {
+	if !common.IsHexAddress(value) {
+		return common.Address{}, errcode.New(errcode.InvalidArgument, "invalid %s: %s", name, value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+// parseBigInt 解析十进制字符串，失败时返回 InvalidArgument
+func parseBigInt(name, value string) (*big.Int, error) {
+	result, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid %s: %s", name, value)
+	}
+	return result, nil
+}
+
+// txFee 交易实际支付的手续费：gasUsed * effectiveGasPrice，blob 交易另加 blobGasUsed * blobGasPrice；
+// 节点未返回 effectiveGasPrice 时退化为交易的 gasPrice（1559 交易即 maxFeePerGas，为上限值）
+func txFee(receipt *ethereumtypes.Receipt, txGasPrice *big.Int) *big.Int {
+	gasPrice := receipt.EffectiveGasPrice
+	if gasPrice == nil {
+		gasPrice = txGasPrice
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	if receipt.BlobGasPrice != nil {
+		fee.Add(fee, new(big.Int).Mul(receipt.BlobGasPrice, new(big.Int).SetUint64(receipt.BlobGasUsed)))
+	}
+	return fee
+}
+
+// wrapRpcError 按节点返回的错误打上分类：未找到、余额不足，其余视为上游节点不可用
+func wrapRpcError(err error, msg string) error {
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		return errcode.Wrap(errcode.NotFound, err, msg)
+	case strings.Contains(err.Error(), "insufficient funds"):
+		return errcode.Wrap(errcode.InsufficientFunds, err, msg)
+	case strings.Contains(err.Error(), "execution reverted"):
+		// 交易本身执行失败（如估算 gas 时 revert），属于请求问题而非节点故障
+		return errcode.Wrap(errcode.InvalidArgument, err, msg)
+	default:
+		return errcode.Wrap(errcode.UpstreamUnavailable, err, msg)
+	}
+}
+
+// 判断是否为任意合约调用
+func isContractCall(tx *Eip1559DynamicFeeTx) bool {
+	return tx.Method != "" || tx.CallData != ""
+}
+
+// 判断是否为 ETH 转账
+func isEthTransfer(tx *Eip1559DynamicFeeTx) bool {
+	return isNativeToken(tx.ContractAddress)
+}
+
+// NewEVMNodeService dataClient 为 nil 表示该链未配置区块浏览器 API
+func NewEVMNodeService(conf ChainConfig, evmClient evmbase.EVMClient, dataClient *evmbase.EthScan) service.WalletAccountService {
+	return &EVMNodeService{
+		conf:       conf,
+		evmClient:  evmClient,
+		dataClient: dataClient,
+		nonces:     newNonceManager(),
+		tokens:     newTokenCache(),
+	}
+}
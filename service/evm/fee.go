@@ -0,0 +1,156 @@
+package evm
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"math"
+	"math/big"
+	"slices"
+)
+
+const (
+	defaultBaseFeeHeadroom  = 100
+	defaultFeeHistoryBlocks = 20
+	// legacyFeeBlocks legacy 链统计 gasPrice 的近期区块数，需要拉取完整区块，不宜过多
+	legacyFeeBlocks = 5
+)
+
+// defaultFeePercentiles 慢 / 中 / 快三档的默认分位数
+var defaultFeePercentiles = []float64{10, 50, 90}
+
+// feeTiers 计算慢 / 中 / 快三档费用，三档保证单调不减：
+//   - EIP-1559 链：maxPriorityFeePerGas 取 eth_feeHistory 各区块对应分位数小费的中位数，
+//     maxFeePerGas = 下一区块 base fee * (100 + headroom) / 100 + maxPriorityFeePerGas，
+//     GasPrice 为预计实际单价 base fee + maxPriorityFeePerGas；
+//   - legacy 链：GasPrice 取近期区块全部交易 gasPrice 的分位数，区块内没有交易时退化为 eth_gasPrice。
+func (s *EVMNodeService) feeTiers(ctx context.Context) ([]domain.GasFee, error) {
+	percentiles := s.conf.Fee.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = defaultFeePercentiles
+	}
+	if s.profile().EIP1559 {
+		return s.dynamicFeeTiers(ctx, percentiles)
+	}
+	return s.legacyFeeTiers(ctx, percentiles)
+}
+
+func (s *EVMNodeService) dynamicFeeTiers(ctx context.Context, percentiles []float64) ([]domain.GasFee, error) {
+	blocks := s.conf.Fee.HistoryBlocks
+	if blocks == 0 {
+		blocks = defaultFeeHistoryBlocks
+	}
+	headroom := s.conf.Fee.BaseFeeHeadroom
+	if headroom == 0 {
+		headroom = defaultBaseFeeHeadroom
+	}
+	history, err := s.evmClient.FeeHistory(ctx, blocks, percentiles)
+	if err != nil {
+		log.Error("get fee history failed", "chain", s.conf.ChainName, "err", err)
+		return nil, wrapRpcError(err, "get fee history failed")
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, errcode.New(errcode.UpstreamUnavailable, "empty fee history")
+	}
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	maxBaseFee := new(big.Int).Mul(nextBaseFee, new(big.Int).SetUint64(100+headroom))
+	maxBaseFee.Div(maxBaseFee, big.NewInt(100))
+
+	// 节点未返回小费（如区块全空）时退化为 eth_maxPriorityFeePerGas
+	var fallbackTip *big.Int
+	tiers := make([]domain.GasFee, len(percentiles))
+	prevTip := new(big.Int)
+	for i := range percentiles {
+		var rewards []*big.Int
+		for _, blockRewards := range history.Reward {
+			if i < len(blockRewards) && blockRewards[i] != nil {
+				rewards = append(rewards, blockRewards[i])
+			}
+		}
+		tip := percentileOf(rewards, 50)
+		if tip == nil {
+			if fallbackTip == nil {
+				if fallbackTip, err = s.evmClient.SuggestGasTipCap(ctx); err != nil {
+					log.Error("get gas tip cap failed", "err", err)
+					return nil, wrapRpcError(err, "get gas tip cap failed")
+				}
+			}
+			tip = fallbackTip
+		}
+		tip = bigMax(tip, prevTip)
+		prevTip = tip
+		tiers[i] = domain.GasFee{
+			GasPrice:             new(big.Int).Add(nextBaseFee, tip).String(),
+			GasTipCap:            tip.String(),
+			MaxFeePerGas:         new(big.Int).Add(maxBaseFee, tip).String(),
+			MaxPriorityFeePerGas: tip.String(),
+		}
+	}
+	return tiers, nil
+}
+
+func (s *EVMNodeService) legacyFeeTiers(ctx context.Context, percentiles []float64) ([]domain.GasFee, error) {
+	var gasPrices []*big.Int
+	var number *big.Int
+	for i := 0; i < legacyFeeBlocks; i++ {
+		block, err := s.evmClient.BlockByNumber(ctx, number)
+		if err != nil {
+			log.Error("get block failed", "chain", s.conf.ChainName, "err", err)
+			return nil, wrapRpcError(err, "get block failed")
+		}
+		for _, tx := range block.Transactions {
+			if gasPrice, err := hexutil.DecodeBig(tx.GasPrice); err == nil {
+				gasPrices = append(gasPrices, gasPrice)
+			}
+		}
+		blockNumber, err := hexutil.DecodeBig(block.Number)
+		if err != nil || blockNumber.Sign() == 0 {
+			break
+		}
+		number = blockNumber.Sub(blockNumber, big.NewInt(1))
+	}
+
+	var fallbackPrice *big.Int
+	if len(gasPrices) == 0 {
+		var err error
+		if fallbackPrice, err = s.evmClient.SuggestGasPrice(ctx); err != nil {
+			log.Error("get gas price failed", "err", err)
+			return nil, wrapRpcError(err, "get gas price failed")
+		}
+	}
+	tiers := make([]domain.GasFee, len(percentiles))
+	prevPrice := new(big.Int)
+	for i, p := range percentiles {
+		gasPrice := fallbackPrice
+		if gasPrice == nil {
+			gasPrice = percentileOf(gasPrices, p)
+		}
+		gasPrice = bigMax(gasPrice, prevPrice)
+		prevPrice = gasPrice
+		tiers[i] = domain.GasFee{
+			GasPrice:  gasPrice.String(),
+			GasTipCap: "0",
+		}
+	}
+	return tiers, nil
+}
+
+// percentileOf 最近秩法取分位数，values 为空时返回 nil
+func percentileOf(values []*big.Int, p float64) *big.Int {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b *big.Int) int { return a.Cmp(b) })
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank-1, 0), len(sorted)-1)]
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,180 @@
+package evm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// nonceReserveTTL 已分配但一直未广播的 nonce 超过该时间视为放弃，释放给后续交易
+	nonceReserveTTL = 10 * time.Minute
+	// nonceStuckAfter 已广播的交易超过该时间仍未上链视为卡住
+	nonceStuckAfter = 5 * time.Minute
+)
+
+// inFlightNonce 已分配给交易、尚未上链的 nonce，TxHash 未签名时为待签名哈希，签名后为交易哈希
+type inFlightNonce struct {
+	state       string
+	txHash      string
+	reservedAt  time.Time
+	broadcastAt time.Time
+}
+
+type accountNonces struct {
+	inFlight map[uint64]*inFlightNonce
+	// latest 上次观察到的已上链 nonce，latestSince 为其最近一次变化的时间，用于判断队首交易是否卡住
+	latest      uint64
+	latestSince time.Time
+}
+
+// next pending 及以上第一个未被占用的 nonce
+func (a *accountNonces) next(pending uint64) uint64 {
+	next := pending
+	for {
+		if _, ok := a.inFlight[next]; !ok {
+			return next
+		}
+		next++
+	}
+}
+
+// nonceManager 按账户跟踪本服务构造出去、尚未上链的 nonce，使连续构造的多笔交易拿到不同的 nonce；
+// 只有 Reserve / Signed / Broadcast 会登记账户，在途 nonce 全部上链或释放后即移除，只读查询不占用内存。
+// 状态只保存在内存中，多实例部署时各实例独立跟踪
+type nonceManager struct {
+	mu       sync.Mutex
+	accounts map[common.Address]*accountNonces
+	now      func() time.Time
+}
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{
+		accounts: make(map[common.Address]*accountNonces),
+		now:      time.Now,
+	}
+}
+
+// sync 用链上的 latest / pending nonce 清理状态：已上链的移除，pending 之下的视为已广播，
+// pending 及以上、超过 nonceReserveTTL 仍未广播的视为放弃；没有在途 nonce 的账户不保留。
+// 未登记的账户返回一个不保存的空状态。调用方须持有锁
+func (m *nonceManager) sync(address common.Address, latest, pending uint64) *accountNonces {
+	now := m.now()
+	account, ok := m.accounts[address]
+	if !ok {
+		return &accountNonces{inFlight: make(map[uint64]*inFlightNonce), latest: latest, latestSince: now}
+	}
+	if account.latestSince.IsZero() || latest != account.latest {
+		account.latest, account.latestSince = latest, now
+	}
+	for nonce, item := range account.inFlight {
+		switch {
+		case nonce < latest:
+			delete(account.inFlight, nonce)
+		case nonce < pending:
+			if item.broadcastAt.IsZero() {
+				item.state, item.broadcastAt = domain.NonceStateBroadcast, now
+			}
+		case item.broadcastAt.IsZero() && now.Sub(item.reservedAt) > nonceReserveTTL:
+			log.Warn("release abandoned nonce", "address", address, "nonce", nonce, "txHash", item.txHash)
+			delete(account.inFlight, nonce)
+		}
+	}
+	if len(account.inFlight) == 0 {
+		delete(m.accounts, address)
+	}
+	return account
+}
+
+// Next 下一笔交易应使用的 nonce：pending 及以上第一个未被占用的 nonce，优先补齐空洞。
+// 只是建议值，不占用该 nonce，并发查询会得到相同的值；交易构造时由 Reserve 占用
+func (m *nonceManager) Next(address common.Address, latest, pending uint64) uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.sync(address, latest, pending).next(pending)
+}
+
+// Reserve 记录 nonce 已分配给某笔交易；同一 nonce 再次构造（如改价重建、加速、取消）时以最新一笔为准
+func (m *nonceManager) Reserve(address common.Address, nonce uint64, txHash string) {
+	m.update(address, nonce, txHash, domain.NonceStateReserved)
+}
+
+// Signed 交易已签名，TxHash 更新为交易哈希
+func (m *nonceManager) Signed(address common.Address, nonce uint64, txHash string) {
+	m.update(address, nonce, txHash, domain.NonceStateSigned)
+}
+
+// Broadcast 交易已通过 SendTx 广播
+func (m *nonceManager) Broadcast(address common.Address, nonce uint64, txHash string) {
+	m.update(address, nonce, txHash, domain.NonceStateBroadcast)
+}
+
+func (m *nonceManager) update(address common.Address, nonce uint64, txHash, state string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	account, ok := m.accounts[address]
+	if !ok {
+		account = &accountNonces{inFlight: make(map[uint64]*inFlightNonce)}
+		m.accounts[address] = account
+	}
+	item, ok := account.inFlight[nonce]
+	if !ok {
+		item = &inFlightNonce{reservedAt: now}
+		account.inFlight[nonce] = item
+	} else if item.txHash != txHash && state == domain.NonceStateReserved {
+		log.Info("nonce rebuilt with another transaction", "address", address, "nonce", nonce, "old", item.txHash, "new", txHash)
+		item.reservedAt, item.broadcastAt = now, time.Time{}
+	}
+	item.state, item.txHash = state, txHash
+	if state == domain.NonceStateBroadcast {
+		item.broadcastAt = now
+	}
+}
+
+// Status 汇总账户的在途 nonce、空洞及卡住的交易
+func (m *nonceManager) Status(address common.Address, latest, pending uint64) domain.NonceStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	account := m.sync(address, latest, pending)
+	status := domain.NonceStatus{
+		LatestNonce:  latest,
+		PendingNonce: pending,
+	}
+	nonces := make([]uint64, 0, len(account.inFlight))
+	for nonce := range account.inFlight {
+		nonces = append(nonces, nonce)
+	}
+	slices.Sort(nonces)
+	for _, nonce := range nonces {
+		item := account.inFlight[nonce]
+		status.InFlight = append(status.InFlight, domain.InFlightNonce{
+			Nonce:  nonce,
+			TxHash: item.txHash,
+			State:  item.state,
+			Age:    uint64(now.Sub(item.reservedAt).Seconds()),
+		})
+		if !item.broadcastAt.IsZero() && now.Sub(item.broadcastAt) > nonceStuckAfter {
+			status.Stuck = append(status.Stuck, nonce)
+		}
+	}
+	// 本服务之外发出的交易无从跟踪，只能根据队首 nonce 长时间未推进判断卡住；
+	// 没有在途 nonce 的账户不保留状态，无法判断，只对本服务有在途交易的账户生效
+	if pending > latest && now.Sub(account.latestSince) > nonceStuckAfter && !slices.Contains(status.Stuck, latest) {
+		status.Stuck = append([]uint64{latest}, status.Stuck...)
+	}
+	// 在途的最大 nonce 之前、pending 及以上未被占用的 nonce 都是空洞，空洞之后的交易无法上链
+	next := pending
+	for _, nonce := range nonces {
+		for ; next < nonce; next++ {
+			status.Gaps = append(status.Gaps, next)
+		}
+		next = max(next, nonce+1)
+	}
+	status.NextNonce = account.next(pending)
+	return status
+}
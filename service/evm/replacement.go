@@ -0,0 +1,174 @@
+package evm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"math/big"
+	"strconv"
+)
+
+// replacementPriceBump 节点交易池替换同 nonce 交易时要求的最低涨价百分比（geth txpool.pricebump 默认 10），
+// 1559 交易的 maxFeePerGas 与 maxPriorityFeePerGas 都要满足
+const replacementPriceBump = 10
+
+// CreateReplacementTransaction 为交易池中尚未打包的交易构造同 nonce 的替换交易：
+// speed_up 原样保留 to / value / data / gas 上限，只提高费用；cancel 改为向自己转 0 的原生币转账。
+// 新费用取「原费用按最低涨幅上调」与「当前快速档费用」中的较大者，调用方也可显式指定，但不能低于最低涨幅。
+// 返回的 Base64Tx 与 CreateUnSignTransaction / BuildSignedTransaction 的请求格式一致，继续走离线签名流程
+func (s *EVMNodeService) CreateReplacementTransaction(ctx context.Context, param domain.ReplacementTransactionParam) (domain.ReplacementTransaction, error) {
+	if param.Mode != domain.ReplacementModeSpeedUp && param.Mode != domain.ReplacementModeCancel {
+		return domain.ReplacementTransaction{}, errcode.New(errcode.InvalidArgument, "unknown replacement mode: %s", param.Mode)
+	}
+	txHash := common.HexToHash(param.TxHash)
+	tx, err := s.evmClient.TxByHash(ctx, txHash)
+	if errors.Is(err, ethereumtypes.ErrTxTypeNotSupported) {
+		// go-ethereum 当前版本无法解码 type 0x04，替换需重新签署授权列表，暂不支持
+		return domain.ReplacementTransaction{}, errcode.New(errcode.Unsupported, "can not replace %s transaction", TxTypeSetCode)
+	}
+	if err != nil {
+		log.Error("get transaction error", "err", err)
+		return domain.ReplacementTransaction{}, wrapRpcError(err, "get transaction error")
+	}
+	// 已有回执说明交易已上链，无法替换
+	if _, err := s.evmClient.TxReceiptByHash(ctx, txHash); err == nil {
+		return domain.ReplacementTransaction{}, errcode.New(errcode.InvalidArgument, "transaction %s is already mined", param.TxHash)
+	} else if !errors.Is(err, ethereum.NotFound) {
+		log.Error("get transaction receipt error", "err", err)
+		return domain.ReplacementTransaction{}, wrapRpcError(err, "get transaction receipt error")
+	}
+	from, err := ethereumtypes.Sender(ethereumtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Error("recover tx sender fail", "err", err)
+		return domain.ReplacementTransaction{}, errcode.Wrap(errcode.SignatureMismatch, err, "recover tx sender fail")
+	}
+
+	txReq, err := s.replacementRequest(ctx, tx, from, param)
+	if err != nil {
+		return domain.ReplacementTransaction{}, err
+	}
+	txReqJson, err := json.Marshal(txReq)
+	if err != nil {
+		return domain.ReplacementTransaction{}, errcode.Wrap(errcode.Unknown, err, "marshal replacement tx fail")
+	}
+	base64Tx := base64.StdEncoding.EncodeToString(txReqJson)
+	unSignTx, err := s.CreateUnSignTransaction(ctx, domain.UnSignTransactionParam{Base64Tx: base64Tx})
+	if err != nil {
+		return domain.ReplacementTransaction{}, err
+	}
+	log.Info("evm CreateReplacementTransaction", "chain", s.conf.ChainName, "mode", param.Mode, "replaced", param.TxHash, "nonce", tx.Nonce())
+	return domain.ReplacementTransaction{
+		Base64Tx: base64Tx,
+		UnSignTx: unSignTx.UnSignTx,
+		Nonce:    tx.Nonce(),
+	}, nil
+}
+
+// replacementRequest 按原交易的类型构造替换交易请求
+func (s *EVMNodeService) replacementRequest(ctx context.Context, tx *ethereumtypes.Transaction, from common.Address, param domain.ReplacementTransactionParam) (*Eip1559DynamicFeeTx, error) {
+	txReq := &Eip1559DynamicFeeTx{
+		TxType:      txTypeName(tx.Type()),
+		ChainId:     strconv.FormatUint(s.conf.ChainId, 10),
+		Nonce:       tx.Nonce(),
+		FromAddress: from.Hex(),
+	}
+	switch tx.Type() {
+	case ethereumtypes.LegacyTxType, ethereumtypes.AccessListTxType, ethereumtypes.DynamicFeeTxType:
+	default:
+		return nil, errcode.New(errcode.Unsupported, "can not replace %s transaction", txReq.TxType)
+	}
+
+	if param.Mode == domain.ReplacementModeCancel {
+		txReq.ToAddress = from.Hex()
+		txReq.Amount = "0"
+		// 带 L1 数据费的 L2（如 Arbitrum）或有 EIP-7702 委托代码的账户，自转账所需 gas 高于 21000，按估算值取
+		estimated, err := s.evmClient.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &from, Value: big.NewInt(0)})
+		if err != nil {
+			log.Error("estimate cancel tx gas failed", "chain", s.conf.ChainName, "err", err)
+			return nil, wrapRpcError(err, "estimate cancel tx gas failed")
+		}
+		txReq.GasLimit = max(s.gasWithMargin(estimated), params.TxGas)
+	} else {
+		if tx.To() == nil {
+			return nil, errcode.New(errcode.Unsupported, "can not speed up contract creation transaction")
+		}
+		txReq.GasLimit = tx.Gas()
+		txReq.AccessList = tx.AccessList()
+		txReq.ToAddress = tx.To().Hex()
+		txReq.Amount = tx.Value().String()
+		// 原交易的 data 原样保留，可能是合约调用，也可能是普通转账附带的备注
+		if len(tx.Data()) > 0 {
+			txReq.RawData = hexutil.Encode(tx.Data())
+		}
+	}
+
+	tiers, err := s.feeTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fast := tiers[len(tiers)-1]
+	if tx.Type() == ethereumtypes.DynamicFeeTxType {
+		tipCap, err := replacementFee("max priority fee", tx.GasTipCap(), param.MaxPriorityFeePerGas, fast.MaxPriorityFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		feeCap, err := replacementFee("max fee", tx.GasFeeCap(), param.MaxFeePerGas, fast.MaxFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		if feeCap.Cmp(tipCap) < 0 {
+			if param.MaxFeePerGas != "" {
+				return nil, errcode.New(errcode.InvalidArgument, "max fee %s is lower than max priority fee %s", feeCap, tipCap)
+			}
+			feeCap = tipCap
+		}
+		txReq.MaxPriorityFeePerGas = tipCap.String()
+		txReq.MaxFeePerGas = feeCap.String()
+		return txReq, nil
+	}
+	gasPrice, err := replacementFee("gas price", tx.GasPrice(), param.GasPrice, fast.GasPrice)
+	if err != nil {
+		return nil, err
+	}
+	txReq.GasPrice = gasPrice.String()
+	return txReq, nil
+}
+
+// replacementFee 替换交易的费用：指定了 requested 时须不低于最低涨幅，否则取最低涨幅与 market 中的较大者
+func replacementFee(name string, old *big.Int, requested, market string) (*big.Int, error) {
+	minFee := minReplacementFee(old)
+	if requested != "" {
+		fee, err := parseBigInt(name, requested)
+		if err != nil {
+			return nil, err
+		}
+		if fee.Cmp(minFee) < 0 {
+			return nil, errcode.New(errcode.InvalidArgument, "replacement %s %s is lower than the minimum %s (+%d%%)", name, fee, minFee, replacementPriceBump)
+		}
+		return fee, nil
+	}
+	if marketFee, ok := new(big.Int).SetString(market, 10); ok && marketFee.Cmp(minFee) > 0 {
+		return marketFee, nil
+	}
+	return minFee, nil
+}
+
+// minReplacementFee old * (100 + replacementPriceBump) / 100，向上取整，且至少比原费用高 1 wei
+func minReplacementFee(old *big.Int) *big.Int {
+	minFee := new(big.Int).Mul(old, big.NewInt(100+replacementPriceBump))
+	minFee.Add(minFee, big.NewInt(99))
+	minFee.Div(minFee, big.NewInt(100))
+	if minFee.Cmp(old) <= 0 {
+		minFee.Add(old, big.NewInt(1))
+	}
+	return minFee
+}
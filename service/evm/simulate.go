@@ -0,0 +1,102 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"math/big"
+)
+
+// SimulateTransaction 以已签名交易的发送方、to、value、data、gas 及费用在 pending 状态上执行 eth_call，
+// revert 时解码 Error(string) / Panic(uint256) 以及 param.Abi 中的自定义错误，不会广播交易
+func (s *EVMNodeService) SimulateTransaction(ctx context.Context, param domain.SimulateTransactionParam) (domain.SimulationResult, error) {
+	contractAbi, err := parseCallAbi(param.Abi)
+	if err != nil {
+		return domain.SimulationResult{}, err
+	}
+	returnData, revert, err := s.simulate(ctx, param.RawTx, contractAbi)
+	if err != nil {
+		return domain.SimulationResult{}, err
+	}
+	if revert != nil {
+		return domain.SimulationResult{Revert: toDomainRevert(revert)}, nil
+	}
+	return domain.SimulationResult{
+		Success:    true,
+		ReturnData: hexutil.Encode(returnData),
+	}, nil
+}
+
+// simulate 执行 eth_call，交易被 revert 时 revert 不为空、err 为空；其余失败（余额不足、节点故障等）返回 err
+func (s *EVMNodeService) simulate(ctx context.Context, rawTx string, contractAbi *abi.ABI) ([]byte, *evmbase.Revert, error) {
+	rawTxBytes, err := decodeRawTx(rawTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	// eth_call 无法携带 EIP-7702 授权列表，模拟结果与实际执行不一致
+	if isSetCodeTx(rawTxBytes) {
+		return nil, nil, errcode.New(errcode.Unsupported, "can not simulate %s transaction", TxTypeSetCode)
+	}
+	tx, from, err := s.decodeSignedTx(rawTxBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+	if tx.Type() == ethereumtypes.LegacyTxType || tx.Type() == ethereumtypes.AccessListTxType {
+		msg.GasPrice = tx.GasPrice()
+	} else {
+		msg.GasFeeCap, msg.GasTipCap = tx.GasFeeCap(), tx.GasTipCap()
+	}
+	returnData, err := s.evmClient.CallContract(ctx, msg, big.NewInt(int64(rpc.PendingBlockNumber)))
+	if err != nil {
+		if data, ok := evmbase.RevertData(err); ok {
+			revert := evmbase.DecodeRevert(data, contractAbi)
+			log.Info("evm simulate transaction reverted", "chain", s.conf.ChainName, "txHash", tx.Hash(), "kind", revert.Kind, "message", revert.Message)
+			return nil, revert, nil
+		}
+		log.Error("simulate transaction error", "err", err)
+		return nil, nil, wrapRpcError(err, "simulate transaction error")
+	}
+	return returnData, nil, nil
+}
+
+// revertError 预执行被 revert 时拒绝广播，调用方可用 errors.As 取出 *evmbase.RevertError
+func revertError(revert *evmbase.Revert) error {
+	return errcode.Wrap(errcode.InvalidArgument, &evmbase.RevertError{Revert: revert}, "transaction simulation reverted")
+}
+
+func toDomainRevert(revert *evmbase.Revert) *domain.RevertReason {
+	args := make([]domain.RevertArg, 0, len(revert.Args))
+	for _, arg := range revert.Args {
+		// 标量直接给出字符串，数组、tuple 给出 JSON
+		value, ok := arg.Value.(string)
+		if !ok {
+			raw, _ := json.Marshal(arg.Value)
+			value = string(raw)
+		}
+		args = append(args, domain.RevertArg{Name: arg.Name, Type: arg.Type, Value: value})
+	}
+	return &domain.RevertReason{
+		Kind:      revert.Kind,
+		Message:   revert.Message,
+		Signature: revert.Signature,
+		Selector:  revert.Selector,
+		Args:      args,
+		Data:      revert.Data,
+	}
+}
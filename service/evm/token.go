@@ -0,0 +1,199 @@
+package evm
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+	"slices"
+	"sync"
+)
+
+const nativeDecimals = 18
+
+// nativeSymbols 原生币符号，未列出的链为 ETH
+var nativeSymbols = map[string]string{
+	BscChain: "BNB",
+	Mantle:   "MNT",
+}
+
+// tokenCache 代币元数据不可变，取到后常驻内存；取不到（非代币合约、节点故障）的不缓存
+type tokenCache struct {
+	mu     sync.RWMutex
+	tokens map[common.Address]domain.TokenInfo
+}
+
+func newTokenCache() *tokenCache {
+	return &tokenCache{tokens: make(map[common.Address]domain.TokenInfo)}
+}
+
+func (c *tokenCache) get(token common.Address) (domain.TokenInfo, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	info, ok := c.tokens[token]
+	return info, ok
+}
+
+func (c *tokenCache) put(token common.Address, info domain.TokenInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[token] = info
+}
+
+// GetTokenInfo 代币的 name / symbol / decimals，ContractAddress 为空或零地址时返回原生币信息
+func (s *EVMNodeService) GetTokenInfo(ctx context.Context, param domain.TokenInfoParam) (domain.TokenInfo, error) {
+	if isNativeToken(param.ContractAddress) {
+		return s.nativeTokenInfo(), nil
+	}
+	if !common.IsHexAddress(param.ContractAddress) {
+		return domain.TokenInfo{}, errcode.New(errcode.InvalidArgument, "invalid contract address: %s", param.ContractAddress)
+	}
+	token := common.HexToAddress(param.ContractAddress)
+	infos, err := s.tokenInfos(ctx, []common.Address{token})
+	if err != nil {
+		return domain.TokenInfo{}, err
+	}
+	info, ok := infos[token]
+	if !ok {
+		return domain.TokenInfo{}, errcode.New(errcode.InvalidArgument, "%s is not a token contract", param.ContractAddress)
+	}
+	return info, nil
+}
+
+func (s *EVMNodeService) nativeTokenInfo() domain.TokenInfo {
+	symbol, ok := nativeSymbols[s.conf.ChainName]
+	if !ok {
+		symbol = "ETH"
+	}
+	return domain.TokenInfo{
+		ContractAddress: common.Address{}.Hex(),
+		Name:            symbol,
+		Symbol:          symbol,
+		Decimals:        nativeDecimals,
+	}
+}
+
+// tokenMetas 给响应补充代币信息用，取不到的代币不在结果中，不影响主流程；key 为 isNativeToken 判定前的原始地址
+func (s *EVMNodeService) tokenMetas(ctx context.Context, contractAddresses []string) map[string]domain.TokenInfo {
+	metas := make(map[string]domain.TokenInfo, len(contractAddresses))
+	var tokens []common.Address
+	for _, contractAddress := range contractAddresses {
+		if isNativeToken(contractAddress) {
+			metas[contractAddress] = s.nativeTokenInfo()
+		} else if common.IsHexAddress(contractAddress) {
+			tokens = append(tokens, common.HexToAddress(contractAddress))
+		}
+	}
+	if len(tokens) == 0 {
+		return metas
+	}
+	infos, err := s.tokenInfos(ctx, tokens)
+	if err != nil {
+		log.Warn("get token info fail, skip token metadata", "chain", s.conf.ChainName, "err", err)
+	}
+	for _, contractAddress := range contractAddresses {
+		if isNativeToken(contractAddress) {
+			continue
+		}
+		if info, ok := infos[common.HexToAddress(contractAddress)]; ok {
+			metas[contractAddress] = info
+		}
+	}
+	return metas
+}
+
+// tokenInfos 先查缓存，未缓存的代币通过一次 Multicall3 调用读取 name / symbol / decimals，
+// Multicall3 不可用时逐个 eth_call；decimals 与 symbol 都取不到的视为非代币合约，不在结果中
+func (s *EVMNodeService) tokenInfos(ctx context.Context, tokens []common.Address) (map[common.Address]domain.TokenInfo, error) {
+	infos := make(map[common.Address]domain.TokenInfo, len(tokens))
+	var missing []common.Address
+	for _, token := range tokens {
+		if info, ok := s.tokens.get(token); ok {
+			infos[token] = info
+		} else if !slices.Contains(missing, token) {
+			missing = append(missing, token)
+		}
+	}
+	if len(missing) == 0 {
+		return infos, nil
+	}
+
+	selectors := [][]byte{evmbase.Erc20NameSelector, evmbase.Erc20SymbolSelector, evmbase.Erc20DecimalsSelector}
+	results, err := s.multicallTokenMeta(ctx, missing, selectors)
+	if err != nil {
+		log.Warn("multicall token metadata fail, fallback to eth_call", "chain", s.conf.ChainName, "err", err)
+		if results, err = s.callTokenMeta(ctx, missing, selectors); err != nil {
+			return infos, err
+		}
+	}
+	for i, token := range missing {
+		name, symbol, decimals := results[i*3], results[i*3+1], results[i*3+2]
+		info := domain.TokenInfo{ContractAddress: token.Hex()}
+		var symbolOk, decimalsOk bool
+		if name.Success {
+			info.Name, _ = evmbase.DecodeTokenString(name.ReturnData)
+		}
+		if symbol.Success {
+			var symbolErr error
+			info.Symbol, symbolErr = evmbase.DecodeTokenString(symbol.ReturnData)
+			symbolOk = symbolErr == nil
+		}
+		if decimals.Success {
+			tokenDecimals, decimalsErr := evmbase.DecodeTokenDecimals(decimals.ReturnData)
+			info.Decimals, decimalsOk = uint32(tokenDecimals), decimalsErr == nil
+		}
+		if !symbolOk && !decimalsOk {
+			continue
+		}
+		s.tokens.put(token, info)
+		infos[token] = info
+	}
+	return infos, nil
+}
+
+// multicallTokenMeta 按 tokens × selectors 的顺序返回调用结果
+func (s *EVMNodeService) multicallTokenMeta(ctx context.Context, tokens []common.Address, selectors [][]byte) ([]evmbase.MulticallResult, error) {
+	calls := make([]evmbase.MulticallCall, 0, len(tokens)*len(selectors))
+	for _, token := range tokens {
+		for _, selector := range selectors {
+			calls = append(calls, evmbase.MulticallCall{Target: token, CallData: selector})
+		}
+	}
+	data, err := evmbase.PackMulticall(calls)
+	if err != nil {
+		return nil, err
+	}
+	multicall3 := s.profile().Multicall3Address()
+	result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{To: &multicall3, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, results, err := evmbase.UnpackMulticall(result)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(calls) {
+		return nil, errcode.New(errcode.UpstreamUnavailable, "multicall3 returned %d results for %d calls", len(results), len(calls))
+	}
+	return results, nil
+}
+
+// callTokenMeta 逐个 eth_call，revert 记为失败，节点故障直接返回错误
+func (s *EVMNodeService) callTokenMeta(ctx context.Context, tokens []common.Address, selectors [][]byte) ([]evmbase.MulticallResult, error) {
+	results := make([]evmbase.MulticallResult, 0, len(tokens)*len(selectors))
+	for _, token := range tokens {
+		for _, selector := range selectors {
+			result, err := s.evmClient.CallContract(ctx, ethereum.CallMsg{To: &token, Data: selector}, nil)
+			if err != nil {
+				if _, reverted := evmbase.RevertData(err); !reverted {
+					return nil, wrapRpcError(err, "call token metadata fail")
+				}
+			}
+			results = append(results, evmbase.MulticallResult{Success: err == nil, ReturnData: result})
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,103 @@
+package evm
+
+import (
+	"encoding/json"
+	ethereumtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/web3-fighter/wallet-chain-account/service/evmbase"
+)
+
+// 请求中 tx_type 的取值，为空时支持 EIP-1559 的链使用 dynamic_fee，否则使用 legacy
+const (
+	TxTypeLegacy     = "legacy"
+	TxTypeAccessList = "access_list"
+	TxTypeDynamicFee = "dynamic_fee"
+	TxTypeSetCode    = "set_code"
+	// TxTypeBlob 仅出现在 DecodeTransaction 的结果中，暂不支持构造 blob 交易
+	TxTypeBlob = "blob"
+)
+
+// Eip1559DynamicFeeTx 构造交易的请求参数，历史原因沿用此名，实际按 TxType 构造不同类型的交易：
+// legacy / access_list 使用 GasPrice，dynamic_fee / set_code 使用 MaxFeePerGas / MaxPriorityFeePerGas，
+// 除 legacy 外均可附带 AccessList，set_code 须带已签名的 AuthorizationList
+type Eip1559DynamicFeeTx struct {
+	TxType      string `json:"tx_type"`
+	ChainId     string `json:"chain_id"`
+	Nonce       uint64 `json:"nonce"`
+	FromAddress string `json:"from_address"`
+	ToAddress   string `json:"to_address"`
+	GasLimit    uint64 `json:"gas_limit"`
+	Gas         uint64 `json:"Gas"`
+
+	MaxFeePerGas         string `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas"`
+	GasPrice             string `json:"gas_price"`
+
+	AccessList ethereumtypes.AccessList `json:"access_list"`
+
+	AuthorizationList []Authorization `json:"authorization_list"`
+
+	// eth/erc20/erc1155 amount，erc721 可不填
+	Amount string `json:"amount"`
+	// erc20 erc721 erc1155 contract_address
+	ContractAddress string `json:"contract_address"`
+	// TokenStandard 合约转账的代币标准：ERC20（默认）/ ERC721 / ERC1155，后两者须填 TokenId
+	TokenStandard string `json:"token_standard"`
+	TokenId       string `json:"token_id"`
+
+	// 合约调用：调用 ContractAddress，Amount 为附带的原生币（可不填）。
+	// 按 Abi（ABI 片段，可为 JSON 或 JSON 字符串）、Method（方法名或完整签名）、Args（JSON 数组）编码，
+	// 或直接给出 CallData（hex），此时 Abi 可选，给出时用于校验调用数据
+	Abi      json.RawMessage `json:"abi"`
+	Method   string          `json:"method"`
+	Args     json.RawMessage `json:"args"`
+	CallData string          `json:"call_data"`
+
+	// RawData 原样作为交易 data 的 hex 数据，发往 ToAddress，不做 ABI 编码与校验，不受 ContractCall 开关限制；
+	// 用于替换交易保留原交易的 data（可能只是几字节的备注），不能与合约地址、合约调用同时使用
+	RawData string `json:"raw_data"`
+}
+
+// Authorization EIP-7702 授权元组，ChainId 为 "0" 表示对所有链有效；
+// Signature 为授权 EOA 对 CreateUnSignAuthorization 返回哈希的 65 字节签名（hex）
+type Authorization struct {
+	ChainId   string `json:"chain_id"`
+	Address   string `json:"address"`
+	Nonce     uint64 `json:"nonce"`
+	Signature string `json:"signature,omitempty"`
+	// Authority 仅解码结果中返回，为签名恢复出的授权 EOA 地址
+	Authority string `json:"authority,omitempty"`
+}
+
+type Eip1559TransactionInfo struct {
+	Hash                 string `json:"hash"`
+	FromAddress          string `json:"from_address"`
+	ToAddress            string `json:"to_address"`
+	Value                string `json:"value"`
+	GasLimit             uint64 `json:"gas_limit"`
+	MaxFeePerGas         string `json:"max_fee_per_gas"`
+	MaxPriorityFeePerGas string `json:"max_priority_fee_per_gas"`
+	// GasPrice 仅 legacy / access_list 交易
+	GasPrice   string                   `json:"gas_price,omitempty"`
+	AccessList ethereumtypes.AccessList `json:"access_list,omitempty"`
+	// Blob* 仅 blob 交易，BlobGas 为交易携带的 blob 数量 * 131072
+	BlobVersionedHashes []string `json:"blob_versioned_hashes,omitempty"`
+	MaxFeePerBlobGas    string   `json:"max_fee_per_blob_gas,omitempty"`
+	BlobGas             uint64   `json:"blob_gas,omitempty"`
+	// AuthorizationList 仅 set_code 交易
+	AuthorizationList []Authorization `json:"authorization_list,omitempty"`
+
+	Nonce   uint64 `json:"nonce"`
+	Data    string `json:"data"`
+	Type    uint8  `json:"type"`
+	TxType  string `json:"tx_type"`
+	ChainId string `json:"chain_id"`
+
+	// eth/erc20/erc1155 amount
+	Amount string `json:"amount"`
+	// erc20 erc721 erc1155 contract_address
+	ContractAddress string `json:"contract_address"`
+	TokenStandard   string `json:"token_standard,omitempty"`
+	TokenId         string `json:"token_id,omitempty"`
+	// Call 按请求中的 ABI（未提供时按常见代币方法）解码出的合约调用
+	Call *evmbase.DecodedCall `json:"call,omitempty"`
+}
@@ -8,18 +8,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
 	"math/big"
 )
 
-func CreateLegacyUnSignTx(txData *types.LegacyTx, chainId *big.Int) string {
-	tx := types.NewTx(txData)
-	signer := types.LatestSignerForChainID(chainId)
-	txHash := signer.Hash(tx)
-	return txHash.String()
-}
-
+// BuildErc721Data 构造 ERC721 safeTransferFrom(address,address,uint256) 的调用数据，Value 为 0，由代币合约转移 tokenId
 func BuildErc721Data(fromAddress, toAddress common.Address, tokenId *big.Int) []byte {
 	var data []byte
 
@@ -39,6 +32,29 @@ func BuildErc721Data(fromAddress, toAddress common.Address, tokenId *big.Int) []
 	return data
 }
 
+// BuildErc1155Data 构造 ERC1155 safeTransferFrom(address,address,uint256,uint256,bytes) 的调用数据，
+// 最后一个参数 data 为动态类型：参数区写入偏移量（5 * 32 字节），其后依次是长度和按 32 字节右补 0 的内容
+func BuildErc1155Data(fromAddress, toAddress common.Address, tokenId, amount *big.Int, extra []byte) []byte {
+	var data []byte
+
+	transferFnSignature := []byte("safeTransferFrom(address,address,uint256,uint256,bytes)")
+	hash := crypto.Keccak256Hash(transferFnSignature)
+	methodId := hash[:4]
+
+	data = append(data, methodId...)
+	data = append(data, common.LeftPadBytes(fromAddress.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(toAddress.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(tokenId.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(5*32).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(extra))).Bytes(), 32)...)
+	if len(extra) > 0 {
+		data = append(data, common.RightPadBytes(extra, (len(extra)+31)/32*32)...)
+	}
+
+	return data
+}
+
 // BuildErc20Data 构造一个标准的 ERC20 transfer(address,uint256) 方法的调用数据（即交易中的 data 字段）， 用于发起代币转账交易。
 func BuildErc20Data(toAddress common.Address, amount *big.Int) []byte {
 	// 定义一个 data 字节切片用于存放最终生成的 ABI 编码数据。
@@ -74,43 +90,44 @@ func BuildErc20Data(toAddress common.Address, amount *big.Int) []byte {
 	return data
 }
 
-// CreateEip1559UnSignTx 创建一个 EIP-1559 格式的未签名交易，并返回该未签名交易的哈希值
+// CreateUnSignTx 创建一个未签名交易（LegacyTx、AccessListTx 或 DynamicFeeTx），并返回该未签名交易的哈希值
 /*
 	构造一笔 EIP-1559 交易（DynamicFeeTx）
 	获取它的 待签名哈希（这个函数做的事 ）
 	将这个哈希发给硬件钱包、冷钱包、HSM 等去做签名
 	再将签名后的完整交易发送上链
 */
-func CreateEip1559UnSignTx(txData *types.DynamicFeeTx, chainId *big.Int) (string, error) {
+func CreateUnSignTx(txData types.TxData, chainId *big.Int) (string, error) {
 	/*
-		创建一个统一的 types.Tx 实例，它是对所有类型交易（LegacyTx、AccessListTx、DynamicFeeTx）的一层封装。
-		你传入的是 DynamicFeeTx，所以最终得到的是 EIP-1559 类型的交易。
+		创建一个统一的 types.Tx 实例，它是对所有类型交易（LegacyTx、AccessListTx、DynamicFeeTx）的一层封装，
+		交易类型由传入的 txData 决定。
 	*/
 	tx := types.NewTx(txData)
-	// 创建一个签名器（Signer），LatestSignerForChainID 会根据链 ID 自动选择正确的签名规则（比如 EIP-1559 的 LondonSigner）。
+	// 创建一个签名器（Signer），LatestSignerForChainID 会根据链 ID 自动选择正确的签名规则：
+	// LegacyTx 按 EIP-155 计算哈希，AccessListTx / DynamicFeeTx 按各自的类型化交易规则计算。
 	signer := types.LatestSignerForChainID(chainId)
 	// 使用 signer 对交易 tx 计算签名哈希（也叫 待签名哈希），这是在离线签名时要用私钥签名的哈希值。
 	txHash := signer.Hash(tx)
 	return txHash.String(), nil
 }
 
-// CreateEip1559SignedTx 将离线签名后的 EIP-1559 交易组装成可广播的签名交易 并返回：
+// CreateSignedTx 将离线签名后的交易组装成可广播的签名交易 并返回：
 /*
 	这是函数签名，输入：
-		txData: 构造好的 EIP-1559 交易结构
+		txData: 构造好的交易结构（LegacyTx、AccessListTx 或 DynamicFeeTx）
 		signature: 离线签名后生成的签名（65 字节：r + s + v）
 		chainId: 链 ID，用于确定签名规则
 	返回：
 		Signer: 用于后续签名验证的 signer 对象
 		*types.Transaction: 已签名的交易
-		string: 已签名交易的 RLP 编码（hex 字符串）
+		string: 已签名交易的规范编码（hex 字符串）：LegacyTx 为 RLP 列表，类型化交易为 type 字节 + RLP
 		string: 交易哈希（txHash）
 		error: 错误信息
 */
-func CreateEip1559SignedTx(txData *types.DynamicFeeTx, signature []byte, chainId *big.Int) (types.Signer, *types.Transaction, string, string, error) {
-	// 将 DynamicFeeTx 包装成 *types.Transaction，这是通用的交易结构体，用于签名和广播。
+func CreateSignedTx(txData types.TxData, signature []byte, chainId *big.Int) (types.Signer, *types.Transaction, string, string, error) {
+	// 将 txData 包装成 *types.Transaction，这是通用的交易结构体，用于签名和广播。
 	tx := types.NewTx(txData)
-	// 根据链 ID 获取当前使用的签名规则，主网上返回 LondonSigner，用于支持 EIP-1559。
+	// 根据链 ID 获取当前使用的签名规则，同时支持 EIP-155 的 LegacyTx 与各类型化交易。
 	signer := types.LatestSignerForChainID(chainId)
 	// 把已经生成好的签名 signature 应用到交易 tx 上，得到 signedTx。
 	// WithSignature 会验证签名格式并将签名字段写入交易对象。
@@ -119,12 +136,13 @@ func CreateEip1559SignedTx(txData *types.DynamicFeeTx, signature []byte, chainId
 	if err != nil {
 		return nil, nil, "", "", errors.New("tx with signature fail")
 	}
-	// 将已签名交易进行 RLP 编码，生成字节流，这就是可以用 eth_sendRawTransaction 广播的原始交易数据。
-	signedTxData, err := rlp.EncodeToBytes(signedTx)
+	// 将已签名交易编码为规范的二进制格式，这就是可以用 eth_sendRawTransaction 广播的原始交易数据。
+	// 注意不能用 rlp.EncodeToBytes：类型化交易会被额外包一层 RLP 字符串头。
+	signedTxData, err := signedTx.MarshalBinary()
 	if err != nil {
 		return nil, nil, "", "", errors.New("encode tx to byte fail")
 	}
-	return signer, signedTx, "0x" + hex.EncodeToString(signedTxData)[4:], signedTx.Hash().String(), nil
+	return signer, signedTx, "0x" + hex.EncodeToString(signedTxData), signedTx.Hash().String(), nil
 }
 
 func toBlockNumArg(number *big.Int) string {
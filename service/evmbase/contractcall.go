@@ -0,0 +1,333 @@
+package evmbase
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// commonCallABI 常见的代币授权 / 转账及 WETH 方法，调用方未提供 ABI 时用于解码调用数据
+const commonCallABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"transferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}]},
+	{"type":"function","name":"setApprovalForAll","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}]},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"tokenId","type":"uint256"}]},
+	{"type":"function","name":"safeTransferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"id","type":"uint256"},{"name":"amount","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"type":"function","name":"deposit","inputs":[]},
+	{"type":"function","name":"withdraw","inputs":[{"name":"amount","type":"uint256"}]}
+]`
+
+// CommonCallABI 见 commonCallABI
+var CommonCallABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(commonCallABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// DecodedCall 按 ABI 解码后的合约调用，参数值均转换为 JSON 友好的形式：整数为十进制字符串，bytes 为 hex
+type DecodedCall struct {
+	Method    string    `json:"method"`
+	Signature string    `json:"signature"`
+	Selector  string    `json:"selector"`
+	Args      []CallArg `json:"args"`
+}
+
+type CallArg struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// ParseABI 解析 ABI 片段，既可以是完整的 ABI 数组，也可以是单个方法对象
+func ParseABI(fragment string) (abi.ABI, error) {
+	fragment = strings.TrimSpace(fragment)
+	if strings.HasPrefix(fragment, "{") {
+		fragment = "[" + fragment + "]"
+	}
+	return abi.JSON(strings.NewReader(fragment))
+}
+
+// PackCall 按 ABI 校验 JSON 数组参数并编码调用数据，method 可以是方法名，重载方法须使用完整签名如 "safeTransferFrom(address,address,uint256)"；
+// 参数中整数可为 JSON 数字或十进制 / 0x 十六进制字符串，address / bytes 为 hex 字符串，tuple 可为对象（按参数名）或数组
+func PackCall(contractAbi abi.ABI, method string, args json.RawMessage) ([]byte, error) {
+	m, err := lookupMethod(contractAbi, method)
+	if err != nil {
+		return nil, err
+	}
+	var rawArgs []json.RawMessage
+	if len(args) > 0 && string(args) != "null" {
+		if err := json.Unmarshal(args, &rawArgs); err != nil {
+			return nil, fmt.Errorf("args must be a JSON array: %w", err)
+		}
+	}
+	if len(rawArgs) != len(m.Inputs) {
+		return nil, fmt.Errorf("method %s expects %d args, got %d", m.Sig, len(m.Inputs), len(rawArgs))
+	}
+	values := make([]any, len(m.Inputs))
+	for i, input := range m.Inputs {
+		v, err := abiValue(input.Type, rawArgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("arg %d (%s %s): %w", i, input.Type, input.Name, err)
+		}
+		values[i] = v.Interface()
+	}
+	packed, err := m.Inputs.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, m.ID...), packed...), nil
+}
+
+// DecodeCall 按 ABI 解码调用数据，选择器不在 ABI 中或参数编码不合法时返回错误
+func DecodeCall(contractAbi abi.ABI, data []byte) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, errors.New("call data too short")
+	}
+	m, err := contractAbi.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	values, err := m.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	call := &DecodedCall{
+		Method:    m.RawName,
+		Signature: m.Sig,
+		Selector:  hexutil.Encode(m.ID),
+		Args:      make([]CallArg, len(m.Inputs)),
+	}
+	for i, input := range m.Inputs {
+		call.Args[i] = CallArg{
+			Name:  input.Name,
+			Type:  input.Type.String(),
+			Value: jsonValue(input.Type, reflect.ValueOf(values[i])),
+		}
+	}
+	return call, nil
+}
+
+func lookupMethod(contractAbi abi.ABI, method string) (abi.Method, error) {
+	if m, ok := contractAbi.Methods[method]; ok && (m.RawName == method || m.Sig == method) {
+		return m, nil
+	}
+	var found []abi.Method
+	for _, m := range contractAbi.Methods {
+		if m.Sig == method || m.RawName == method {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return abi.Method{}, fmt.Errorf("method %s not found in abi", method)
+	case 1:
+		return found[0], nil
+	default:
+		return abi.Method{}, fmt.Errorf("method %s is overloaded, use the full signature", method)
+	}
+}
+
+// abiValue 把 JSON 参数转换为 abi 包编码所需的 Go 类型（abi.Type.GetType()），同时校验取值范围与长度
+func abiValue(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := jsonBigInt(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := checkIntRange(t, n); err != nil {
+			return reflect.Value{}, err
+		}
+		typ := t.GetType()
+		if typ == reflect.TypeOf(new(big.Int)) {
+			return reflect.ValueOf(n), nil
+		}
+		v := reflect.New(typ).Elem()
+		if t.T == abi.UintTy {
+			v.SetUint(n.Uint64())
+		} else {
+			v.SetInt(n.Int64())
+		}
+		return v, nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(s), nil
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		if !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("invalid address %q", s)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy, abi.FixedBytesTy, abi.FunctionTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, err
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid hex %q: %w", s, err)
+		}
+		if t.T == abi.BytesTy {
+			return reflect.ValueOf(b), nil
+		}
+		if len(b) != t.Size {
+			return reflect.Value{}, fmt.Errorf("expect %d bytes, got %d", t.Size, len(b))
+		}
+		v := reflect.New(t.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return reflect.Value{}, err
+		}
+		var v reflect.Value
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(t.GetType(), len(items), len(items))
+		} else {
+			if len(items) != t.Size {
+				return reflect.Value{}, fmt.Errorf("expect %d elements, got %d", t.Size, len(items))
+			}
+			v = reflect.New(t.GetType()).Elem()
+		}
+		for i, item := range items {
+			elem, err := abiValue(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			v.Index(i).Set(elem)
+		}
+		return v, nil
+	case abi.TupleTy:
+		items, err := tupleItems(t, raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v := reflect.New(t.GetType()).Elem()
+		for i, elemType := range t.TupleElems {
+			elem, err := abiValue(*elemType, items[i])
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("field %s: %w", t.TupleRawNames[i], err)
+			}
+			v.Field(i).Set(elem)
+		}
+		return v, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported abi type %s", t)
+	}
+}
+
+// tupleItems tuple 参数按字段顺序展开，支持 JSON 对象（按参数名）与数组两种写法
+func tupleItems(t abi.Type, raw json.RawMessage) ([]json.RawMessage, error) {
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '{' {
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, err
+		}
+		items := make([]json.RawMessage, len(t.TupleRawNames))
+		for i, name := range t.TupleRawNames {
+			item, ok := fields[name]
+			if !ok {
+				return nil, fmt.Errorf("missing field %s", name)
+			}
+			items[i] = item
+		}
+		return items, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	if len(items) != len(t.TupleElems) {
+		return nil, fmt.Errorf("expect %d fields, got %d", len(t.TupleElems), len(items))
+	}
+	return items, nil
+}
+
+func jsonBigInt(raw json.RawMessage) (*big.Int, error) {
+	s := string(bytes.TrimSpace(raw))
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+	}
+	n, ok := new(big.Int), false
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		n, ok = n.SetString(s[2:], 16)
+	} else {
+		n, ok = n.SetString(s, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %s", s)
+	}
+	return n, nil
+}
+
+func checkIntRange(t abi.Type, n *big.Int) error {
+	if t.T == abi.UintTy {
+		if n.Sign() < 0 || n.BitLen() > t.Size {
+			return fmt.Errorf("%s out of range for %s", n, t)
+		}
+		return nil
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+		return fmt.Errorf("%s out of range for %s", n, t)
+	}
+	return nil
+}
+
+// jsonValue 把 abi 包解码出的值转换为 JSON 友好的形式
+func jsonValue(t abi.Type, v reflect.Value) any {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		if n, ok := v.Interface().(*big.Int); ok {
+			return n.String()
+		}
+		return fmt.Sprint(v.Interface())
+	case abi.AddressTy:
+		return v.Interface().(common.Address).Hex()
+	case abi.BytesTy:
+		return hexutil.Encode(v.Bytes())
+	case abi.FixedBytesTy, abi.FunctionTy, abi.HashTy:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Encode(b)
+	case abi.SliceTy, abi.ArrayTy:
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = jsonValue(*t.Elem, v.Index(i))
+		}
+		return items
+	case abi.TupleTy:
+		fields := make(map[string]any, len(t.TupleElems))
+		for i, elemType := range t.TupleElems {
+			fields[t.TupleRawNames[i]] = jsonValue(*elemType, v.Field(i))
+		}
+		return fields
+	default:
+		return v.Interface()
+	}
+}
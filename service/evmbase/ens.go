@@ -0,0 +1,106 @@
+package evmbase
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"strings"
+)
+
+// DefaultEnsRegistry ENS Registry 在以太坊主网及 Sepolia / Holesky 测试网的部署地址，其余链须在 ChainProfile.EnsRegistry 中登记
+var DefaultEnsRegistry = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+// ensABI 只包含用到的方法：Registry.resolver 查询节点的解析器，Resolver.addr / name 分别用于正向、反向解析
+const ensABI = `[
+	{"type":"function","name":"resolver","stateMutability":"view",
+		"inputs":[{"name":"node","type":"bytes32"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"addr","stateMutability":"view",
+		"inputs":[{"name":"node","type":"bytes32"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"name","stateMutability":"view",
+		"inputs":[{"name":"node","type":"bytes32"}],"outputs":[{"name":"","type":"string"}]}
+]`
+
+var ens = mustParseABI(ensABI)
+
+// IsEnsName 带 "." 且不是十六进制地址的字符串视为 ENS 名称，如 vitalik.eth
+func IsEnsName(name string) bool {
+	return strings.Contains(name, ".") && !common.IsHexAddress(name)
+}
+
+// NormalizeEnsName 转小写并校验各级标签非空；未实现 ENSIP-15 的完整 Unicode 规范化，
+// 非 ASCII 名称直接拒绝，避免对未规范化的名称计算 namehash 解析到别的节点
+func NormalizeEnsName(name string) (string, error) {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if name == "" {
+		return "", errors.New("empty ens name")
+	}
+	for _, r := range name {
+		if r <= 0x20 || r >= 0x7f {
+			return "", fmt.Errorf("unsupported character %q in ens name", r)
+		}
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return "", fmt.Errorf("empty label in ens name %s", name)
+		}
+	}
+	return name, nil
+}
+
+// Namehash EIP-137：从顶级标签开始，node = keccak256(node, keccak256(label))，根节点为 32 字节 0
+func Namehash(name string) common.Hash {
+	var node common.Hash
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256Hash(node.Bytes(), crypto.Keccak256([]byte(labels[i])))
+	}
+	return node
+}
+
+// ReverseNode 地址的反向解析节点 <小写十六进制地址，不带 0x>.addr.reverse
+func ReverseNode(address common.Address) common.Hash {
+	return Namehash(strings.ToLower(strings.TrimPrefix(address.Hex(), "0x")) + ".addr.reverse")
+}
+
+// BuildEnsResolverData Registry.resolver(node) 的调用数据
+func BuildEnsResolverData(node common.Hash) []byte {
+	data, _ := ens.Pack("resolver", node)
+	return data
+}
+
+// BuildEnsAddrData Resolver.addr(node) 的调用数据
+func BuildEnsAddrData(node common.Hash) []byte {
+	data, _ := ens.Pack("addr", node)
+	return data
+}
+
+// BuildEnsNameData Resolver.name(node) 的调用数据
+func BuildEnsNameData(node common.Hash) []byte {
+	data, _ := ens.Pack("name", node)
+	return data
+}
+
+// UnpackAddress 解码返回单个 address 的调用结果，如 resolver / addr
+func UnpackAddress(data []byte) (common.Address, error) {
+	if len(data) < 32 {
+		return common.Address{}, errors.New("invalid address return data")
+	}
+	return common.BytesToAddress(data[12:32]), nil
+}
+
+// UnpackEnsName 解码 Resolver.name(node) 的返回值
+func UnpackEnsName(data []byte) (string, error) {
+	values, err := ens.Unpack("name", data)
+	if err != nil {
+		return "", err
+	}
+	name, ok := values[0].(string)
+	if !ok {
+		return "", errors.New("invalid ens name return data")
+	}
+	return name, nil
+}
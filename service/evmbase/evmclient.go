@@ -10,7 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
 	"github.com/web3-fighter/wallet-chain-account/pkg/helpers"
 	"github.com/web3-fighter/wallet-chain-account/pkg/retry"
 	"math/big"
@@ -27,7 +27,8 @@ const (
 var _ EVMClient = (*evmClient)(nil)
 
 type evmClient struct {
-	evmRpc RPC
+	evmRpc  RPC
+	chainId uint64
 }
 
 func (c *evmClient) BlockHeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
@@ -66,8 +67,11 @@ func (c *evmClient) BlockHeaderByHash(ctx context.Context, hash common.Hash) (*t
 	return header, nil
 }
 
-// BlockHeadersByRange 兼容多链的 批量获取区块头的 RPC 调用实现
-func (c *evmClient) BlockHeadersByRange(ctx context.Context, startHeight, endHeight *big.Int, chainId uint) ([]types.Header, error) {
+// BlockHeadersByRange 兼容多链的 批量获取区块头的 RPC 调用实现，是否批量及批大小由 ChainProfile 决定
+func (c *evmClient) BlockHeadersByRange(ctx context.Context, startHeight, endHeight *big.Int) ([]types.Header, error) {
+	if startHeight.Sign() < 0 || startHeight.Cmp(endHeight) > 0 {
+		return nil, errcode.New(errcode.InvalidArgument, "invalid block range: %s-%s", startHeight, endHeight)
+	}
 	if startHeight.Cmp(endHeight) == 0 {
 		header, err := c.BlockHeaderByNumber(ctx, startHeight)
 		if err != nil {
@@ -79,64 +83,54 @@ func (c *evmClient) BlockHeadersByRange(ctx context.Context, startHeight, endHei
 	count := new(big.Int).Sub(endHeight, startHeight).Uint64() + 1
 	headers := make([]types.Header, count)
 	batchElems := make([]rpc.BatchElem, count)
+	for i := uint64(0); i < count; i++ {
+		height := new(big.Int).Add(startHeight, new(big.Int).SetUint64(i))
+		batchElems[i] = rpc.BatchElem{Method: "eth_getBlockByNumber", Args: []interface{}{toBlockNumArg(height), false}, Result: &headers[i]}
+	}
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
 	defer cancel()
-	// ZkFair 相关链
-	if chainId == uint(domain.ZkFairSepoliaChainId) ||
-		chainId == uint(domain.ZkFairChainId) {
-		groupSize := 100
-		var wg sync.WaitGroup
-		numGroups := (int(count)-1)/groupSize + 1
-		wg.Add(numGroups)
-		// 每 groupSize 个 block 启动一个 goroutine 查询
-		for i := 0; i < int(count); i += groupSize {
-			start := i
-			end := i + groupSize - 1
-			if end > int(count) {
-				end = int(count) - 1
-			}
-			go func(start, end int) {
-				defer wg.Done()
-				for j := start; j <= end; j++ {
-					height := new(big.Int).Add(startHeight, new(big.Int).SetUint64(uint64(j)))
-					//对 batchElems 的并发写入是安全的，因为每个 goroutine 只写入自己负责的区间（不重叠）；
-					batchElems[j] = rpc.BatchElem{
-						Method: "eth_getBlockByNumber",
-						Result: new(types.Header),
-						Error:  nil,
-					}
-					header := new(types.Header)
-					batchElems[j].Error = c.evmRpc.CallContext(ctxwt, header, batchElems[j].Method, toBlockNumArg(height), false)
-					batchElems[j].Result = header
-				}
-			}(start, end)
-		}
-		wg.Wait()
-	} else {
-		for i := uint64(0); i < count; i++ {
-			height := new(big.Int).Add(startHeight, new(big.Int).SetUint64(i))
-			batchElems[i] = rpc.BatchElem{Method: "eth_getBlockByNumber", Args: []interface{}{toBlockNumArg(height), false}, Result: &headers[i]}
-		}
-		// 普通链：使用以太坊 RPC 的 批量调用（batch）机制 请求所有区块头
-		err := c.evmRpc.BatchCallContext(ctxwt, batchElems)
-		if err != nil {
-			return nil, err
-		}
+	if err := c.batchCall(ctxwt, batchElems); err != nil {
+		return nil, err
 	}
-	size := 0
-	for i, batchElem := range batchElems {
-		header, ok := batchElem.Result.(*types.Header)
-		if !ok {
-			return nil, fmt.Errorf("unable to transform rpc response %v into types.Header", batchElem.Result)
+	for _, batchElem := range batchElems {
+		if batchElem.Error != nil {
+			return nil, fmt.Errorf("get block header %v fail: %w", batchElem.Args[0], batchElem.Error)
 		}
-		headers[i] = *header
-		size = size + 1
 	}
-	headers = headers[:size]
-
 	return headers, nil
 }
 
+// batchCall 节点支持批量请求时按 MaxBatchSize 分批发送，否则以 MaxBatchSize 为并发上限逐个请求，
+// 单个请求的错误记录在 BatchElem.Error 中。
+func (c *evmClient) batchCall(ctx context.Context, batchElems []rpc.BatchElem) error {
+	profile := ProfileOf(c.chainId)
+	if profile.Batch {
+		for start := 0; start < len(batchElems); start += profile.MaxBatchSize {
+			end := min(start+profile.MaxBatchSize, len(batchElems))
+			if err := c.evmRpc.BatchCallContext(ctx, batchElems[start:end]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, profile.MaxBatchSize)
+	for i := range batchElems {
+		wg.Add(1)
+		limit <- struct{}{}
+		// 每个 goroutine 只写入自己负责的 batchElem，不存在并发写冲突
+		go func(elem *rpc.BatchElem) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+			elem.Error = c.evmRpc.CallContext(ctx, elem.Result, elem.Method, elem.Args...)
+		}(&batchElems[i])
+	}
+	wg.Wait()
+	return nil
+}
+
 func (c *evmClient) BlockByNumber(ctx context.Context, number *big.Int) (*RpcBlock, error) {
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
 	defer cancel()
@@ -173,6 +167,9 @@ func (c *evmClient) LatestSafeBlockHeader(ctx context.Context) (*types.Header, e
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
 	defer cancel()
 
+	if !ProfileOf(c.chainId).SafeTag {
+		return nil, errcode.New(errcode.Unsupported, "chain %d does not support safe block tag", c.chainId)
+	}
 	var header *types.Header
 	// eth_getBlockByNumber 方法支持传 "safe" 和 "finalized" 作为区块编号参数。
 	err := c.evmRpc.CallContext(ctxwt, &header, "eth_getBlockByNumber", "safe", false)
@@ -191,6 +188,9 @@ func (c *evmClient) LatestFinalizedBlockHeader(ctx context.Context) (*types.Head
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
 	defer cancel()
 
+	if !ProfileOf(c.chainId).FinalizedTag {
+		return nil, errcode.New(errcode.Unsupported, "chain %d does not support finalized block tag", c.chainId)
+	}
 	var header *types.Header
 	err := c.evmRpc.CallContext(ctxwt, &header, "eth_getBlockByNumber", "finalized", false)
 	if err != nil {
@@ -215,6 +215,18 @@ func (c *evmClient) TxCountByAddress(ctx context.Context, address common.Address
 	return nonce, err
 }
 
+// PendingNonceAt 含交易池中待打包交易的 nonce（eth_getTransactionCount pending），即下一笔交易可用的 nonce
+func (c *evmClient) PendingNonceAt(ctx context.Context, address common.Address) (uint64, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+	var nonce hexutil.Uint64
+	if err := c.evmRpc.CallContext(ctxwt, &nonce, "eth_getTransactionCount", address, "pending"); err != nil {
+		log.Error("Call eth_getTransactionCount method fail", "err", err)
+		return 0, err
+	}
+	return uint64(nonce), nil
+}
+
 // SuggestGasPrice 获取当前网络推荐的 传统 Gas 单价（单位是 Wei），主要用于 非 EIP-1559（legacy）交易 的定价
 // 交易发送者直接设置 gasPrice，全额支付给矿工。
 func (c *evmClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
@@ -253,6 +265,62 @@ func (c *evmClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	return (*big.Int)(&hex), nil
 }
 
+// FeeHistory 调用 eth_feeHistory 获取截至最新区块的 blockCount 个区块的 base fee 及小费分位数，
+// 返回的 BaseFee 比区块数多一个，最后一个即下一个区块的 base fee
+func (c *evmClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+	var res struct {
+		OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+		Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+		BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+		GasUsedRatio []float64        `json:"gasUsedRatio"`
+	}
+	if err := c.evmRpc.CallContext(ctxwt, &res, "eth_feeHistory", hexutil.Uint(blockCount), "latest", rewardPercentiles); err != nil {
+		return nil, err
+	}
+	reward := make([][]*big.Int, len(res.Reward))
+	for i, r := range res.Reward {
+		reward[i] = make([]*big.Int, len(r))
+		for j, r := range r {
+			reward[i][j] = (*big.Int)(r)
+		}
+	}
+	baseFee := make([]*big.Int, len(res.BaseFee))
+	for i, b := range res.BaseFee {
+		baseFee[i] = (*big.Int)(b)
+	}
+	return &ethereum.FeeHistory{
+		OldestBlock:  (*big.Int)(res.OldestBlock),
+		Reward:       reward,
+		BaseFee:      baseFee,
+		GasUsedRatio: res.GasUsedRatio,
+	}, nil
+}
+
+// EstimateGas 调用 eth_estimateGas 估算执行 msg 所需的 gas，交易执行会 revert 时节点直接返回错误
+func (c *evmClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+	var hex hexutil.Uint64
+	if err := c.evmRpc.CallContext(ctxwt, &hex, "eth_estimateGas", toCallArg(msg)); err != nil {
+		return 0, err
+	}
+	return uint64(hex), nil
+}
+
+// CallContract 调用 eth_call 在 blockNumber 状态上执行 msg，blockNumber 为 nil 时取最新区块，
+// 传 rpc.PendingBlockNumber 时在 pending 状态上执行；revert 时返回的错误可用 RevertData 取出 revert 数据
+func (c *evmClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+	var hex hexutil.Bytes
+	if err := c.evmRpc.CallContext(ctxwt, &hex, "eth_call", toCallArg(msg), toBlockNumArg(blockNumber)); err != nil {
+		return nil, err
+	}
+	return hex, nil
+}
+
 func (c *evmClient) SendRawTransaction(ctx context.Context, rawTx string) (*common.Hash, error) {
 	var txHash common.Hash
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
@@ -281,6 +349,23 @@ func (c *evmClient) TxByHash(ctx context.Context, hash common.Hash) (*types.Tran
 	return tx, nil
 }
 
+// RawTxByHash 查询交易的规范编码（eth_getRawTransactionByHash），
+// 用于 go-ethereum 当前版本无法解码的交易类型，如 EIP-7702 SetCodeTx
+func (c *evmClient) RawTxByHash(ctx context.Context, hash common.Hash) ([]byte, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+
+	var raw hexutil.Bytes
+	err := c.evmRpc.CallContext(ctxwt, &raw, "eth_getRawTransactionByHash", hash)
+	if err != nil {
+		return nil, err
+	} else if len(raw) == 0 {
+		return nil, ethereum.NotFound
+	}
+
+	return raw, nil
+}
+
 // TxReceiptByHash 根据交易哈希 `hash` 查询这笔交易的 **执行结果、状态、事件日志等信息**。
 func (c *evmClient) TxReceiptByHash(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
@@ -311,24 +396,19 @@ func (c *evmClient) GetStorageHash(ctx context.Context, address common.Address,
 	return proof.StorageHash, nil
 }
 
-// EthGetCode 判断一个以太坊地址是 EOA（Externally Owned Account，外部账户） 还是 合约账户（Contract Account） 的工具方法。
-// 如果返回为空（0x），说明是 EOA；否则是合约。
-func (c *evmClient) EthGetCode(ctx context.Context, address common.Address) (string, error) {
+// EthGetCode 判断一个以太坊地址是 EOA（Externally Owned Account，外部账户）、合约账户（Contract Account）
+// 还是 EIP-7702 委托的 EOA 的工具方法。
+// 如果返回为空（0x），说明是 EOA；代码为 0xef0100 || address 时是委托 EOA；否则是合约。
+func (c *evmClient) EthGetCode(ctx context.Context, address common.Address) (AccountCode, error) {
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
 	defer cancel()
 
 	var result hexutil.Bytes
 	err := c.evmRpc.CallContext(ctxwt, &result, "eth_getCode", address, "latest")
 	if err != nil {
-		return "", err
-	}
-	// 如果是普通地址（EOA，Externally Owned Account）：返回空字符串（0x）；
-	if result.String() == "0x" {
-		return "eoa", nil
-	} else {
-		// 如果是合约地址：装成了 "contract" 返回
-		return "contract", nil
+		return AccountCode{}, err
 	}
+	return ClassifyCode(result), nil
 }
 
 // GetBalance 使用以太坊 JSON-RPC 接口 eth_getBalance 查询指定地址在最新区块上的余额，并返回一个 *big.Int 类型的余额值（单位为 Wei）。
@@ -346,8 +426,9 @@ func (c *evmClient) GetBalance(ctx context.Context, address common.Address) (*bi
 	return balance, nil
 }
 
-// FilterLogs 根据指定的过滤条件（如区块范围、合约地址、事件等）查询日志信息，并附带日志所在的 ToBlock 的区块头，适配了evm系列不同链（如 ZkFair）的调用方式。
-func (c *evmClient) FilterLogs(ctx context.Context, filterQuery ethereum.FilterQuery, chainId uint) (Logs, error) {
+// FilterLogs 根据指定的过滤条件（如区块范围、合约地址、事件等）查询日志信息，并附带日志所在的 ToBlock 的区块头，
+// 不支持批量请求的链（如 ZkFair）按 ChainProfile 逐个请求。
+func (c *evmClient) FilterLogs(ctx context.Context, filterQuery ethereum.FilterQuery) (Logs, error) {
 	arg, err := toFilterArg(filterQuery)
 	if err != nil {
 		return Logs{}, err
@@ -361,16 +442,8 @@ func (c *evmClient) FilterLogs(ctx context.Context, filterQuery ethereum.FilterQ
 	batchElems[1] = rpc.BatchElem{Method: "eth_getLogs", Args: []interface{}{arg}, Result: &logs}
 	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout*10)
 	defer cancel()
-	if chainId == uint(domain.ZkFairSepoliaChainId) ||
-		chainId == uint(domain.ZkFairChainId) {
-
-		batchElems[0].Error = c.evmRpc.CallContext(ctxwt, &header, batchElems[0].Method, toBlockNumArg(filterQuery.ToBlock), false)
-		batchElems[1].Error = c.evmRpc.CallContext(ctxwt, &logs, batchElems[1].Method, arg)
-	} else {
-		err = c.evmRpc.BatchCallContext(ctxwt, batchElems)
-		if err != nil {
-			return Logs{}, err
-		}
+	if err = c.batchCall(ctxwt, batchElems); err != nil {
+		return Logs{}, err
 	}
 	if batchElems[0].Error != nil {
 		return Logs{}, fmt.Errorf("unable to query for the `FilterQuery#ToBlock` header: %w", batchElems[0].Error)
@@ -381,11 +454,42 @@ func (c *evmClient) FilterLogs(ctx context.Context, filterQuery ethereum.FilterQ
 	return Logs{Logs: logs, ToBlockHeader: &header}, nil
 }
 
+// TraceTransaction 通过 debug_traceTransaction（callTracer）获取交易的完整调用树，需节点开放 debug 命名空间
+func (c *evmClient) TraceTransaction(ctx context.Context, hash common.Hash) (*CallFrame, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout*3)
+	defer cancel()
+
+	var frame *CallFrame
+	err := c.evmRpc.CallContext(ctxwt, &frame, "debug_traceTransaction", hash, callTracerConfig)
+	if err != nil {
+		log.Error("Call debug_traceTransaction method fail", "err", err)
+		return nil, err
+	} else if frame == nil {
+		return nil, ethereum.NotFound
+	}
+	return frame, nil
+}
+
+// TraceBlockByNumber 通过 debug_traceBlockByNumber（callTracer）获取区块内每笔交易的调用树，顺序与区块内交易一致
+func (c *evmClient) TraceBlockByNumber(ctx context.Context, number *big.Int) ([]TxTraceResult, error) {
+	ctxwt, cancel := context.WithTimeout(ctx, defaultRequestTimeout*10)
+	defer cancel()
+
+	var results []TxTraceResult
+	err := c.evmRpc.CallContext(ctxwt, &results, "debug_traceBlockByNumber", toBlockNumArg(number), callTracerConfig)
+	if err != nil {
+		log.Error("Call debug_traceBlockByNumber method fail", "err", err)
+		return nil, err
+	}
+	return results, nil
+}
+
 func (c *evmClient) Close(_ context.Context) {
 	c.evmRpc.Close()
 }
 
-func DialEthClient(ctx context.Context, rpcUrl string) (EVMClient, error) {
+// DialEthClient chainId 用于查找 ChainProfile
+func DialEthClient(ctx context.Context, rpcUrl string, chainId uint64) (EVMClient, error) {
 	ctx, cancel := context.WithTimeout(ctx, defaultDialTimeout)
 	defer cancel()
 
@@ -407,5 +511,71 @@ func DialEthClient(ctx context.Context, rpcUrl string) (EVMClient, error) {
 		return nil, err
 	}
 
-	return &evmClient{evmRpc: NewRPC(rpcCli)}, nil
+	return &evmClient{evmRpc: NewRPC(rpcCli), chainId: chainId}, nil
+}
+
+// NewEVMClient 基于已建立的 rpc 连接创建客户端，如 ethclient/simulated 模拟链的连接
+func NewEVMClient(client *rpc.Client, chainId uint64) EVMClient {
+	return &evmClient{evmRpc: NewRPC(client), chainId: chainId}
+}
+
+// DialEthClients 多个节点地址时返回基于 multiRPC 的客户端，在节点间分摊请求并自动故障转移；
+// 单个地址时等同于 DialEthClient。
+func DialEthClients(ctx context.Context, rpcUrls []string, chainId uint64, conf MultiRPCConfig) (EVMClient, error) {
+	if len(rpcUrls) == 0 {
+		return nil, errors.New("no rpc url configured")
+	}
+	if len(rpcUrls) == 1 {
+		return DialEthClient(ctx, rpcUrls[0], chainId)
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultDialTimeout)
+	defer cancel()
+
+	urls := make([]string, 0, len(rpcUrls))
+	clients := make([]RPC, 0, len(rpcUrls))
+	for _, rpcUrl := range rpcUrls {
+		client, err := rpc.DialContext(ctx, rpcUrl)
+		if err != nil {
+			log.Warn("dial rpc endpoint fail, skip", "url", rpcUrl, "err", err)
+			continue
+		}
+		urls = append(urls, rpcUrl)
+		clients = append(clients, NewRPC(client))
+	}
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("failed to dial any of %d rpc endpoints", len(rpcUrls))
+	}
+	return &evmClient{evmRpc: NewMultiRPC(urls, clients, conf), chainId: chainId}, nil
+}
+
+// toCallArg 与 ethclient 一致，把 CallMsg 转为 eth_call / eth_estimateGas 的调用参数；
+// 部分节点只认 data 不认 input，两者同时带上
+func toCallArg(msg ethereum.CallMsg) any {
+	arg := map[string]any{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["input"] = hexutil.Bytes(msg.Data)
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.GasFeeCap != nil {
+		arg["maxFeePerGas"] = (*hexutil.Big)(msg.GasFeeCap)
+	}
+	if msg.GasTipCap != nil {
+		arg["maxPriorityFeePerGas"] = (*hexutil.Big)(msg.GasTipCap)
+	}
+	if msg.AccessList != nil {
+		arg["accessList"] = msg.AccessList
+	}
+	return arg
 }
@@ -0,0 +1,92 @@
+package evmbase
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+	"strings"
+)
+
+// DefaultMulticall3 Multicall3 在绝大多数 EVM 链上的统一部署地址，部署地址不同的链在 ChainProfile.Multicall3 中登记
+var DefaultMulticall3 = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// Erc20BalanceOfSelector balanceOf(address)
+var Erc20BalanceOfSelector = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+
+// multicall3ABI 只包含用到的方法：tryBlockAndAggregate 允许单个调用失败并返回执行所在的区块号，
+// getEthBalance 用于在同一次调用中查询原生币余额
+const multicall3ABI = `[
+	{"type":"function","name":"tryBlockAndAggregate","stateMutability":"payable",
+		"inputs":[{"name":"requireSuccess","type":"bool"},{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"callData","type":"bytes"}]}],
+		"outputs":[{"name":"blockNumber","type":"uint256"},{"name":"blockHash","type":"bytes32"},{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]},
+	{"type":"function","name":"getEthBalance","stateMutability":"view",
+		"inputs":[{"name":"addr","type":"address"}],"outputs":[{"name":"balance","type":"uint256"}]}
+]`
+
+var multicall3 = mustParseABI(multicall3ABI)
+
+func mustParseABI(fragment string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(fragment))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// MulticallCall Multicall3 的单个调用，字段名须与 ABI 中的 components 对应
+type MulticallCall struct {
+	Target   common.Address
+	CallData []byte
+}
+
+// MulticallResult 单个调用的结果，Success 为 false 时 ReturnData 为 revert 数据
+type MulticallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// PackMulticall 编码 tryBlockAndAggregate(false, calls)
+func PackMulticall(calls []MulticallCall) ([]byte, error) {
+	return multicall3.Pack("tryBlockAndAggregate", false, calls)
+}
+
+// UnpackMulticall 解码 tryBlockAndAggregate 的返回值，results 与调用顺序一致
+func UnpackMulticall(data []byte) (*big.Int, []MulticallResult, error) {
+	if len(data) == 0 {
+		return nil, nil, errors.New("empty multicall result, multicall3 may not be deployed")
+	}
+	values, err := multicall3.Unpack("tryBlockAndAggregate", data)
+	if err != nil {
+		return nil, nil, err
+	}
+	blockNumber, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, nil, errors.New("invalid multicall block number")
+	}
+	results := *abi.ConvertType(values[2], new([]MulticallResult)).(*[]MulticallResult)
+	return blockNumber, results, nil
+}
+
+// BuildEthBalanceData Multicall3.getEthBalance(address) 的调用数据
+func BuildEthBalanceData(owner common.Address) []byte {
+	data, _ := multicall3.Pack("getEthBalance", owner)
+	return data
+}
+
+// BuildErc20BalanceOfData balanceOf(address) 的调用数据
+func BuildErc20BalanceOfData(owner common.Address) []byte {
+	var data []byte
+	data = append(data, Erc20BalanceOfSelector...)
+	data = append(data, common.LeftPadBytes(owner.Bytes(), 32)...)
+	return data
+}
+
+// UnpackUint256 解码返回单个 uint256 的调用结果，如 balanceOf / getEthBalance
+func UnpackUint256(data []byte) (*big.Int, error) {
+	if len(data) < 32 {
+		return nil, errors.New("invalid uint256 return data")
+	}
+	return new(big.Int).SetBytes(data[:32]), nil
+}
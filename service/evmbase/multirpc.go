@@ -0,0 +1,342 @@
+package evmbase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+const (
+	defaultHealthInterval = 15 * time.Second
+	defaultProbeTimeout   = 5 * time.Second
+	defaultMaxHeadLag     = 10
+	defaultMaxErrorRate   = 0.5
+	defaultMaxLatency     = 5 * time.Second
+	// defaultAttemptTimeout 小于 defaultRequestTimeout，单个节点卡住时仍留有时间换节点重试
+	defaultAttemptTimeout = 4 * time.Second
+	defaultEjectDuration  = 30 * time.Second
+	// ewmaWeight 延迟与错误率的指数滑动平均权重，越大越看重最近一次结果
+	ewmaWeight = 0.2
+)
+
+// MultiRPCConfig 多节点 RPC 的健康检查参数，零值字段使用默认值
+type MultiRPCConfig struct {
+	// BroadcastAll eth_sendRawTransaction 同时发往所有健康节点
+	BroadcastAll bool
+	// MaxHeadLag 节点区块高度落后最高节点超过该值时视为不健康
+	MaxHeadLag uint64
+	// HealthInterval 后台探测 eth_blockNumber 的间隔
+	HealthInterval time.Duration
+	// MaxLatency 平均延迟超过该值的节点视为不健康，后台探测会持续更新延迟，恢复后重新参与选择
+	MaxLatency time.Duration
+	// AttemptTimeout 幂等请求在单个节点上的超时，超时后换下一个节点，不占满整个请求的超时时间
+	AttemptTimeout time.Duration
+}
+
+// endpoint 单个节点及其健康统计
+type endpoint struct {
+	url string
+	rpc RPC
+
+	mu           sync.Mutex
+	latency      time.Duration
+	errorRate    float64
+	head         uint64
+	ejectedUntil time.Time
+}
+
+// observe 记录一次调用结果，错误率超过阈值时摘除一段时间
+func (e *endpoint) observe(elapsed time.Duration, failed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.latency == 0 {
+		e.latency = elapsed
+	} else {
+		e.latency = time.Duration(ewmaWeight*float64(elapsed) + (1-ewmaWeight)*float64(e.latency))
+	}
+	sample := 0.0
+	if failed {
+		sample = 1
+	}
+	e.errorRate = ewmaWeight*sample + (1-ewmaWeight)*e.errorRate
+	if failed && e.errorRate > defaultMaxErrorRate && time.Now().After(e.ejectedUntil) {
+		e.ejectedUntil = time.Now().Add(defaultEjectDuration)
+		log.Warn("eject unhealthy rpc endpoint", "url", e.url, "errorRate", e.errorRate, "latency", e.latency)
+	}
+}
+
+func (e *endpoint) setHead(head uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.head = head
+}
+
+func (e *endpoint) healthy(now time.Time, maxHead, maxHeadLag uint64, maxLatency time.Duration) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if now.Before(e.ejectedUntil) || e.latency > maxLatency {
+		return false
+	}
+	return e.head == 0 || maxHead-e.head <= maxHeadLag
+}
+
+// score 越小越优：延迟按错误率放大
+func (e *endpoint) score() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return float64(e.latency) * (1 + 4*e.errorRate)
+}
+
+var _ RPC = (*multiRPC)(nil)
+
+// multiRPC 实现 RPC 接口，把调用分散到多个节点：
+// 按延迟、错误率、区块高度落后程度挑选节点，幂等读请求失败后换节点重试。
+type multiRPC struct {
+	endpoints []*endpoint
+	conf      MultiRPCConfig
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewMultiRPC urls 与 clients 一一对应，返回的 RPC 在 Close 前会持续做后台健康检查
+func NewMultiRPC(urls []string, clients []RPC, conf MultiRPCConfig) RPC {
+	if conf.MaxHeadLag == 0 {
+		conf.MaxHeadLag = defaultMaxHeadLag
+	}
+	if conf.HealthInterval <= 0 {
+		conf.HealthInterval = defaultHealthInterval
+	}
+	if conf.MaxLatency <= 0 {
+		conf.MaxLatency = defaultMaxLatency
+	}
+	if conf.AttemptTimeout <= 0 {
+		conf.AttemptTimeout = defaultAttemptTimeout
+	}
+	m := &multiRPC{conf: conf}
+	for i, client := range clients {
+		m.endpoints = append(m.endpoints, &endpoint{url: urls[i], rpc: client})
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.wg.Add(1)
+	go m.healthLoop(ctx)
+	return m
+}
+
+func (m *multiRPC) Close() {
+	m.cancel()
+	m.wg.Wait()
+	for _, e := range m.endpoints {
+		e.rpc.Close()
+	}
+}
+
+func (m *multiRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	if method == "eth_sendRawTransaction" && m.conf.BroadcastAll {
+		return m.broadcast(ctx, result, method, args...)
+	}
+	return m.do(ctx, isIdempotent(method), func(ctx context.Context, e *endpoint) error {
+		return e.rpc.CallContext(ctx, result, method, args...)
+	}, nil)
+}
+
+func (m *multiRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	idempotent := true
+	for _, elem := range b {
+		if !isIdempotent(elem.Method) {
+			idempotent = false
+			break
+		}
+	}
+	return m.do(ctx, idempotent, func(ctx context.Context, e *endpoint) error {
+		return e.rpc.BatchCallContext(ctx, b)
+	}, func() bool {
+		return isBatchDegraded(b)
+	})
+}
+
+// isBatchDegraded 批量请求整体成功但单个元素失败：有元素是节点故障（如缺少响应），或超过一半元素返回错误
+func isBatchDegraded(b []rpc.BatchElem) bool {
+	failed := 0
+	for _, elem := range b {
+		if elem.Error == nil {
+			continue
+		}
+		if isEndpointError(elem.Error) {
+			return true
+		}
+		failed++
+	}
+	return failed*2 > len(b)
+}
+
+// do 选取节点执行 call；节点自身故障且请求幂等时换一个未尝试过的节点重试。
+// 幂等请求每次尝试使用独立的 AttemptTimeout，单个节点超时只结束本次尝试；非幂等请求（如发送交易）不设单次超时，避免误判失败。
+// degraded 不为空时在调用成功后判断结果是否部分失败，只计入节点错误率，不重试
+func (m *multiRPC) do(ctx context.Context, idempotent bool, call func(ctx context.Context, e *endpoint) error, degraded func() bool) error {
+	tried := make(map[*endpoint]struct{}, len(m.endpoints))
+	var lastErr error
+	for len(tried) < len(m.endpoints) {
+		e := m.pick(tried)
+		tried[e] = struct{}{}
+		start := time.Now()
+		err := m.attempt(ctx, idempotent, e, call)
+		faulty := isEndpointError(err)
+		e.observe(time.Since(start), faulty || (err == nil && degraded != nil && degraded()))
+		if !faulty || !idempotent || ctx.Err() != nil {
+			return err
+		}
+		log.Warn("rpc endpoint fail, try next", "url", e.url, "err", err)
+		lastErr = err
+	}
+	return fmt.Errorf("all rpc endpoints failed: %w", lastErr)
+}
+
+// attempt 在单个节点上执行一次 call
+func (m *multiRPC) attempt(ctx context.Context, idempotent bool, e *endpoint, call func(ctx context.Context, e *endpoint) error) error {
+	if !idempotent {
+		return call(ctx, e)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, m.conf.AttemptTimeout)
+	defer cancel()
+	return call(attemptCtx, e)
+}
+
+// broadcast 并发发往所有健康节点，任一节点成功即返回成功
+func (m *multiRPC) broadcast(ctx context.Context, result any, method string, args ...any) error {
+	targets := m.healthyEndpoints()
+	raws := make([]json.RawMessage, len(targets))
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, e := range targets {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			start := time.Now()
+			errs[i] = e.rpc.CallContext(ctx, &raws[i], method, args...)
+			e.observe(time.Since(start), isEndpointError(errs[i]))
+		}(i, e)
+	}
+	wg.Wait()
+	var firstErr error
+	for i := range targets {
+		if errs[i] == nil {
+			return json.Unmarshal(raws[i], result)
+		}
+		if firstErr == nil || isEndpointError(firstErr) {
+			firstErr = errs[i]
+		}
+	}
+	return firstErr
+}
+
+// pick 在未尝试过的健康节点中随机取两个，选分数更优者；没有健康节点时退化为全部节点
+func (m *multiRPC) pick(tried map[*endpoint]struct{}) *endpoint {
+	var candidates []*endpoint
+	for _, e := range m.healthyEndpoints() {
+		if _, ok := tried[e]; !ok {
+			candidates = append(candidates, e)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, e := range m.endpoints {
+			if _, ok := tried[e]; !ok {
+				candidates = append(candidates, e)
+			}
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	a, b := candidates[rand.IntN(len(candidates))], candidates[rand.IntN(len(candidates))]
+	if b.score() < a.score() {
+		return b
+	}
+	return a
+}
+
+func (m *multiRPC) healthyEndpoints() []*endpoint {
+	now := time.Now()
+	maxHead := m.maxHead()
+	healthy := make([]*endpoint, 0, len(m.endpoints))
+	for _, e := range m.endpoints {
+		if e.healthy(now, maxHead, m.conf.MaxHeadLag, m.conf.MaxLatency) {
+			healthy = append(healthy, e)
+		}
+	}
+	if len(healthy) == 0 {
+		return m.endpoints
+	}
+	return healthy
+}
+
+func (m *multiRPC) maxHead() uint64 {
+	var maxHead uint64
+	for _, e := range m.endpoints {
+		e.mu.Lock()
+		maxHead = max(maxHead, e.head)
+		e.mu.Unlock()
+	}
+	return maxHead
+}
+
+// healthLoop 定期探测各节点的 eth_blockNumber，更新区块高度、延迟及错误率
+func (m *multiRPC) healthLoop(ctx context.Context) {
+	defer m.wg.Done()
+	ticker := time.NewTicker(m.conf.HealthInterval)
+	defer ticker.Stop()
+	for {
+		m.probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *multiRPC) probe(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, e := range m.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			pCtx, cancel := context.WithTimeout(ctx, defaultProbeTimeout)
+			defer cancel()
+			var head hexutil.Uint64
+			start := time.Now()
+			err := e.rpc.CallContext(pCtx, &head, "eth_blockNumber")
+			if ctx.Err() != nil {
+				return
+			}
+			e.observe(time.Since(start), err != nil)
+			if err != nil {
+				log.Warn("probe rpc endpoint fail", "url", e.url, "err", err)
+				return
+			}
+			e.setHead(uint64(head))
+		}(e)
+	}
+	wg.Wait()
+}
+
+// isIdempotent 发送交易类请求重试可能导致重复广播，不做重试
+func isIdempotent(method string) bool {
+	return method != "eth_sendRawTransaction" && method != "eth_sendTransaction"
+}
+
+// isEndpointError 区分节点故障（网络、HTTP 状态码、超时）与节点正常返回的 JSON-RPC 错误（如 revert、nonce too low）
+func isEndpointError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
@@ -0,0 +1,100 @@
+package evmbase
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/web3-fighter/wallet-chain-account/domain"
+	"sync"
+)
+
+const defaultMaxBatchSize = 100
+
+// ChainProfile 各 EVM 链在 JSON-RPC 及协议层面的差异
+type ChainProfile struct {
+	ChainId uint64
+	// Batch 节点支持 JSON-RPC 批量请求；不支持时逐个请求
+	Batch bool
+	// MaxBatchSize 单次批量请求的最大条数，超过时分多批发送
+	MaxBatchSize int
+	// EIP1559 支持 DynamicFeeTx 及 baseFee
+	EIP1559 bool
+	// EIP4844 区块头带 blobGasUsed / excessBlobGas
+	EIP4844 bool
+	// EIP7702 支持 SetCodeTx（type 0x04）
+	EIP7702 bool
+	// SafeTag / FinalizedTag 节点支持 "safe" / "finalized" 区块标签
+	SafeTag      bool
+	FinalizedTag bool
+	// Multicall3 部署地址，零值使用 DefaultMulticall3
+	Multicall3 common.Address
+	// EnsRegistry ENS Registry 部署地址，零值表示该链不支持 ENS
+	EnsRegistry common.Address
+}
+
+// Multicall3Address 该链的 Multicall3 部署地址
+func (p ChainProfile) Multicall3Address() common.Address {
+	if p.Multicall3 == (common.Address{}) {
+		return DefaultMulticall3
+	}
+	return p.Multicall3
+}
+
+// defaultProfile 未登记的链按支持批量请求和 EIP-1559 处理
+var defaultProfile = ChainProfile{
+	Batch:        true,
+	MaxBatchSize: defaultMaxBatchSize,
+	EIP1559:      true,
+}
+
+var (
+	profilesMu sync.RWMutex
+	profiles   = map[uint64]ChainProfile{}
+)
+
+func init() {
+	for _, profile := range []ChainProfile{
+		{ChainId: domain.EthereumChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, EIP4844: true, SafeTag: true, FinalizedTag: true, EIP7702: true, EnsRegistry: DefaultEnsRegistry},
+		{ChainId: domain.EthereumSepoliaChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, EIP4844: true, SafeTag: true, FinalizedTag: true, EIP7702: true, EnsRegistry: DefaultEnsRegistry},
+		{ChainId: domain.ArbitrumChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true},
+		{ChainId: domain.OpChinId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true, EIP7702: true},
+		{ChainId: domain.OpTestChinId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true, EIP7702: true},
+		{ChainId: domain.BaseChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true, EIP7702: true},
+		{ChainId: domain.BaseSepoliaChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true, EIP7702: true},
+		// zkSync Era 的合约地址推导规则不同，Multicall3 不在统一地址
+		{ChainId: domain.ZksyncChainId, Batch: true, MaxBatchSize: 50, EIP1559: true, FinalizedTag: true, Multicall3: common.HexToAddress("0xF9cda624FBC7e059355ce98a31693d299FACd963")},
+		{ChainId: domain.BscChainId, Batch: true, MaxBatchSize: 50, EIP1559: true, EIP4844: true, SafeTag: true, FinalizedTag: true, EIP7702: true},
+		{ChainId: domain.PolygonPosChainId, Batch: true, MaxBatchSize: 50, EIP1559: true, SafeTag: true, FinalizedTag: true},
+		{ChainId: domain.PolygonChainId, Batch: true, MaxBatchSize: 50, FinalizedTag: true},
+		{ChainId: domain.PolygonSepoliaChainId, Batch: true, MaxBatchSize: 50, FinalizedTag: true},
+		{ChainId: domain.MantleChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true},
+		{ChainId: domain.MantleSepoliaChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true},
+		{ChainId: domain.ScrollChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true},
+		{ChainId: domain.LineaChainId, Batch: true, MaxBatchSize: 100, EIP1559: true, SafeTag: true, FinalizedTag: true},
+		// ZkFair 节点不支持批量请求
+		{ChainId: domain.ZkFairChainId},
+		{ChainId: domain.ZkFairSepoliaChainId},
+	} {
+		RegisterProfile(profile)
+	}
+}
+
+// RegisterProfile 登记（或覆盖）某条链的 profile，用于从配置中追加新链
+func RegisterProfile(profile ChainProfile) {
+	if profile.MaxBatchSize <= 0 {
+		profile.MaxBatchSize = defaultMaxBatchSize
+	}
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+	profiles[profile.ChainId] = profile
+}
+
+// ProfileOf 返回链的 profile，未登记时返回 defaultProfile
+func ProfileOf(chainId uint64) ChainProfile {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+	if profile, ok := profiles[chainId]; ok {
+		return profile
+	}
+	profile := defaultProfile
+	profile.ChainId = chainId
+	return profile
+}
@@ -0,0 +1,139 @@
+package evmbase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// Revert 的种类
+const (
+	// RevertKindError require / revert("...") 抛出的 Error(string)
+	RevertKindError = "error"
+	// RevertKindPanic assert 失败、溢出、除零等抛出的 Panic(uint256)
+	RevertKindPanic = "panic"
+	// RevertKindCustom 按调用方提供的 ABI 解出的自定义错误
+	RevertKindCustom = "custom"
+	// RevertKindUnknown 无 revert 数据或无法识别的选择器
+	RevertKindUnknown = "unknown"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons Solidity 内置的 Panic 错误码
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// Revert 解码后的 revert 原因，Args 仅自定义错误有，取值形式同 DecodedCall
+type Revert struct {
+	Kind      string    `json:"kind"`
+	Message   string    `json:"message"`
+	Signature string    `json:"signature,omitempty"`
+	Selector  string    `json:"selector,omitempty"`
+	Args      []CallArg `json:"args,omitempty"`
+	Data      string    `json:"data,omitempty"`
+}
+
+// RevertError 交易模拟执行被 revert，调用方可用 errors.As 取出 Revert
+type RevertError struct {
+	Revert *Revert
+}
+
+func (e *RevertError) Error() string {
+	return "execution reverted: " + e.Revert.Message
+}
+
+// RevertData 从 eth_call / eth_estimateGas 的 JSON-RPC 错误中取出 revert 数据；
+// 不是 revert 错误时 ok 为 false，revert 但没有数据（如 revert()）时返回空切片
+func RevertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if b, decodeErr := hexutil.Decode(data); decodeErr == nil {
+				return b, true
+			}
+		}
+	}
+	if err != nil && strings.Contains(err.Error(), "execution reverted") {
+		return []byte{}, true
+	}
+	return nil, false
+}
+
+// DecodeRevert 依次按 Error(string)、Panic(uint256)、contractAbi 中的自定义错误解码 revert 数据，contractAbi 可为空
+func DecodeRevert(data []byte, contractAbi *abi.ABI) *Revert {
+	revert := &Revert{Kind: RevertKindUnknown, Message: "execution reverted"}
+	if len(data) == 0 {
+		return revert
+	}
+	revert.Data = hexutil.Encode(data)
+	if len(data) < 4 {
+		return revert
+	}
+	selector := data[:4]
+	revert.Selector = hexutil.Encode(selector)
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			revert.Kind, revert.Signature, revert.Message = RevertKindError, "Error(string)", reason
+		}
+		return revert
+	case bytes.Equal(selector, panicSelector):
+		if len(data) != 36 {
+			return revert
+		}
+		code := new(big.Int).SetBytes(data[4:])
+		reason := "unknown panic code"
+		if code.IsUint64() {
+			if known, ok := panicReasons[code.Uint64()]; ok {
+				reason = known
+			}
+		}
+		revert.Kind, revert.Signature = RevertKindPanic, "Panic(uint256)"
+		revert.Message = fmt.Sprintf("panic 0x%x: %s", code, reason)
+		return revert
+	}
+	if contractAbi == nil {
+		return revert
+	}
+	for _, abiErr := range contractAbi.Errors {
+		if !bytes.Equal(abiErr.ID[:4], selector) {
+			continue
+		}
+		values, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return revert
+		}
+		revert.Kind, revert.Signature, revert.Message = RevertKindCustom, abiErr.Sig, abiErr.Name
+		revert.Args = make([]CallArg, len(abiErr.Inputs))
+		for i, input := range abiErr.Inputs {
+			revert.Args[i] = CallArg{
+				Name:  input.Name,
+				Type:  input.Type.String(),
+				Value: jsonValue(input.Type, reflect.ValueOf(values[i])),
+			}
+		}
+		return revert
+	}
+	return revert
+}
@@ -0,0 +1,218 @@
+package evmbase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"math/big"
+)
+
+/*
+	EIP-7702 SetCodeTx（type 0x04）。
+	当前依赖的 go-ethereum 版本尚未提供 types.SetCodeTx，这里按 EIP-7702 自行完成编码、哈希与签名恢复：
+		交易待签名哈希 = keccak256(0x04 || rlp([chain_id, nonce, max_priority_fee_per_gas, max_fee_per_gas, gas_limit,
+			destination, value, data, access_list, authorization_list]))
+		授权待签名哈希 = keccak256(0x05 || rlp([chain_id, address, nonce]))
+	被授权的 EOA 代码会被设置为 0xef0100 || delegate address。
+*/
+
+const (
+	SetCodeTxType = 0x04
+	// authorizationMagic EIP-7702 授权签名哈希的前缀
+	authorizationMagic = 0x05
+)
+
+// DelegationPrefix 7702 委托 EOA 的代码前缀，其后紧跟 20 字节委托合约地址
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// SetCodeAuthorization 授权元组，ChainID 为 0 表示对所有链有效
+type SetCodeAuthorization struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint8
+	R       *big.Int
+	S       *big.Int
+}
+
+// SigHash 授权元组的待签名哈希，交给授权 EOA 离线签名
+func (a *SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRlpHash(authorizationMagic, []any{a.ChainID, a.Address, a.Nonce})
+}
+
+// WithSignature 写入 65 字节签名（r || s || v，v 为 0/1 或 27/28）
+func (a *SetCodeAuthorization) WithSignature(sig []byte) error {
+	r, s, v, err := splitSignature(sig)
+	if err != nil {
+		return err
+	}
+	a.R, a.S, a.V = r, s, v
+	return nil
+}
+
+// Authority 从签名恢复授权的 EOA 地址
+func (a *SetCodeAuthorization) Authority() (common.Address, error) {
+	return recoverAddress(a.SigHash(), a.R, a.S, a.V)
+}
+
+// SetCodeTx EIP-7702 交易，To 不能为空（不支持创建合约）
+type SetCodeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList types.AccessList
+	AuthList   []SetCodeAuthorization
+	V          *big.Int
+	R          *big.Int
+	S          *big.Int
+}
+
+// SigHash 交易的待签名哈希
+func (tx *SetCodeTx) SigHash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, []any{
+		tx.ChainID,
+		tx.Nonce,
+		tx.GasTipCap,
+		tx.GasFeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList,
+		tx.AuthList,
+	})
+}
+
+// WithSignature 写入 65 字节签名（r || s || v）
+func (tx *SetCodeTx) WithSignature(sig []byte) error {
+	r, s, v, err := splitSignature(sig)
+	if err != nil {
+		return err
+	}
+	tx.R, tx.S, tx.V = r, s, new(big.Int).SetUint64(uint64(v))
+	return nil
+}
+
+// Sender 从签名恢复交易发送方
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	if tx.V == nil || !tx.V.IsUint64() || tx.V.Uint64() > 1 {
+		return common.Address{}, errors.New("invalid set code tx signature")
+	}
+	return recoverAddress(tx.SigHash(), tx.R, tx.S, uint8(tx.V.Uint64()))
+}
+
+// MarshalBinary 已签名交易的规范编码：0x04 || rlp(交易字段 + 签名)
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(SetCodeTxType)
+	if err := rlp.Encode(&buf, tx); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Hash 已签名交易的交易哈希
+func (tx *SetCodeTx) Hash() (common.Hash, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(raw), nil
+}
+
+// DecodeSetCodeTx 解码 0x04 开头的已签名交易
+func DecodeSetCodeTx(raw []byte) (*SetCodeTx, error) {
+	if len(raw) == 0 || raw[0] != SetCodeTxType {
+		return nil, errors.New("not a set code transaction")
+	}
+	var tx SetCodeTx
+	if err := rlp.DecodeBytes(raw[1:], &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// CreateSignedSetCodeTx 与 CreateSignedTx 对应，返回已签名交易、规范编码（hex）及交易哈希
+func CreateSignedSetCodeTx(tx *SetCodeTx, signature []byte) (*SetCodeTx, string, string, error) {
+	signedTx := *tx
+	if err := signedTx.WithSignature(signature); err != nil {
+		return nil, "", "", err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, "", "", errors.New("encode tx to byte fail")
+	}
+	return &signedTx, "0x" + common.Bytes2Hex(raw), crypto.Keccak256Hash(raw).String(), nil
+}
+
+// 账户类型
+const (
+	AccountKindEOA          = "eoa"
+	AccountKindContract     = "contract"
+	AccountKindDelegatedEOA = "delegated_eoa"
+)
+
+// AccountCode eth_getCode 结果的分类，Delegate 仅 7702 委托 EOA 有值
+type AccountCode struct {
+	Kind     string
+	Delegate *common.Address
+}
+
+// ClassifyCode 无代码为 EOA，0xef0100 || address 为 7702 委托 EOA，其余为合约
+func ClassifyCode(code []byte) AccountCode {
+	switch {
+	case len(code) == 0:
+		return AccountCode{Kind: AccountKindEOA}
+	case len(code) == len(DelegationPrefix)+common.AddressLength && bytes.HasPrefix(code, DelegationPrefix):
+		delegate := common.BytesToAddress(code[len(DelegationPrefix):])
+		return AccountCode{Kind: AccountKindDelegatedEOA, Delegate: &delegate}
+	default:
+		return AccountCode{Kind: AccountKindContract}
+	}
+}
+
+func prefixedRlpHash(prefix byte, x any) common.Hash {
+	var buf bytes.Buffer
+	buf.WriteByte(prefix)
+	// 编码的都是固定结构，不会失败
+	_ = rlp.Encode(&buf, x)
+	return crypto.Keccak256Hash(buf.Bytes())
+}
+
+func splitSignature(sig []byte) (r, s *big.Int, v uint8, err error) {
+	if len(sig) != crypto.SignatureLength {
+		return nil, nil, 0, fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+	v = sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return nil, nil, 0, fmt.Errorf("invalid signature recovery id: %d", sig[64])
+	}
+	return new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]), v, nil
+}
+
+func recoverAddress(hash common.Hash, r, s *big.Int, v uint8) (common.Address, error) {
+	if r == nil || s == nil || !crypto.ValidateSignatureValues(v, r, s, true) {
+		return common.Address{}, errors.New("invalid signature values")
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = v
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
@@ -0,0 +1,52 @@
+package evmbase
+
+import (
+	"bytes"
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// ERC20 元数据方法选择器，ERC721 同样实现 name() / symbol()
+var (
+	Erc20NameSelector     = crypto.Keccak256([]byte("name()"))[:4]
+	Erc20SymbolSelector   = crypto.Keccak256([]byte("symbol()"))[:4]
+	Erc20DecimalsSelector = crypto.Keccak256([]byte("decimals()"))[:4]
+)
+
+var stringType, _ = abi.NewType("string", "", nil)
+
+// DecodeTokenString 解码 name() / symbol() 的返回值：标准代币返回 ABI 编码的 string，
+// MKR、SAI 等早期代币返回 bytes32，去掉末尾补齐的 0
+func DecodeTokenString(data []byte) (string, error) {
+	if len(data) > 32 {
+		values, err := abi.Arguments{{Type: stringType}}.Unpack(data)
+		if err == nil {
+			if s, ok := values[0].(string); ok && utf8.ValidString(s) {
+				return s, nil
+			}
+		}
+	}
+	if len(data) == 32 {
+		s := string(bytes.TrimRight(data, "\x00"))
+		if utf8.ValidString(s) && !strings.ContainsRune(s, 0) {
+			return s, nil
+		}
+	}
+	return "", errors.New("invalid token string return data")
+}
+
+// DecodeTokenDecimals 解码 decimals() 的返回值，按 uint8 校验
+func DecodeTokenDecimals(data []byte) (uint8, error) {
+	decimals, err := UnpackUint256(data)
+	if err != nil {
+		return 0, err
+	}
+	if decimals.Cmp(big.NewInt(255)) > 0 {
+		return 0, errors.New("token decimals out of range")
+	}
+	return uint8(decimals.Uint64()), nil
+}
@@ -0,0 +1,69 @@
+package evmbase
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+)
+
+// callTracerConfig debug_trace* 使用 geth 内置的 callTracer
+var callTracerConfig = map[string]any{"tracer": "callTracer"}
+
+// CallFrame callTracer 返回的调用帧，Calls 为该帧内发起的子调用
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// TxTraceResult debug_traceBlockByNumber 中单笔交易的追踪结果，部分节点不返回 txHash，需按下标对应区块内交易
+type TxTraceResult struct {
+	TxHash common.Hash `json:"txHash"`
+	Result *CallFrame  `json:"result"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// InternalTransfer 合约内部调用产生的原生币转账
+type InternalTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
+
+// InternalTransfers 深度优先收集根调用之下所有带金额的 CALL / CREATE / SELFDESTRUCT 子调用。
+// 根调用即交易本身的 value，不重复计入；执行失败的调用帧及其子调用已被回滚，一并跳过；
+// DELEGATECALL 的 value 只是沿用调用者的上下文，并不发生转账。
+func InternalTransfers(root *CallFrame) []InternalTransfer {
+	if root == nil || root.Error != "" {
+		return nil
+	}
+	var transfers []InternalTransfer
+	var walk func(frames []CallFrame)
+	walk = func(frames []CallFrame) {
+		for _, frame := range frames {
+			if frame.Error != "" {
+				continue
+			}
+			switch frame.Type {
+			case "CALL", "CREATE", "CREATE2", "SELFDESTRUCT":
+				if frame.Value != nil && frame.Value.ToInt().Sign() > 0 && frame.To != nil {
+					transfers = append(transfers, InternalTransfer{
+						From:  frame.From,
+						To:    *frame.To,
+						Value: frame.Value.ToInt(),
+					})
+				}
+			}
+			walk(frame.Calls)
+		}
+	}
+	walk(root.Calls)
+	return transfers
+}
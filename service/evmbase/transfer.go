@@ -0,0 +1,166 @@
+package evmbase
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+// 代币标准
+const (
+	TokenStandardERC20   = "ERC20"
+	TokenStandardERC721  = "ERC721"
+	TokenStandardERC1155 = "ERC1155"
+)
+
+var (
+	// Transfer(address indexed from, address indexed to, uint256 value)，ERC721 的第三个参数 tokenId 也是 indexed
+	TransferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+	TransferSingleEventTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+	TransferBatchEventTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+)
+
+var uint256ArrayPair = func() abi.Arguments {
+	uint256Array, _ := abi.NewType("uint256[]", "", nil)
+	return abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}
+}()
+
+// 代币转账方法选择器
+var (
+	// transfer(address,uint256) = 0xa9059cbb
+	Erc20TransferSelector = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	// safeTransferFrom(address,address,uint256) = 0x42842e0e
+	Erc721SafeTransferFromSelector = crypto.Keccak256([]byte("safeTransferFrom(address,address,uint256)"))[:4]
+	// safeTransferFrom(address,address,uint256,uint256,bytes) = 0xf242432a
+	Erc1155SafeTransferFromSelector = crypto.Keccak256([]byte("safeTransferFrom(address,address,uint256,uint256,bytes)"))[:4]
+)
+
+// TokenTransfer 从交易事件或调用数据中解析出的一笔代币转移，ERC20 的 TokenId 为空，ERC721 的 Value 固定为 1
+type TokenTransfer struct {
+	Standard string
+	Token    common.Address
+	From     common.Address
+	To       common.Address
+	TokenId  *big.Int
+	Value    *big.Int
+}
+
+// ParseTransferLogs 按日志顺序解析 ERC20 / ERC721 Transfer 及 ERC1155 TransferSingle / TransferBatch 事件，
+// 不符合标准编码的日志直接跳过
+func ParseTransferLogs(logs []*types.Log) []TokenTransfer {
+	var transfers []TokenTransfer
+	for _, l := range logs {
+		if l == nil || len(l.Topics) == 0 || l.Removed {
+			continue
+		}
+		switch l.Topics[0] {
+		case TransferEventTopic:
+			if transfer, ok := parseTransfer(l); ok {
+				transfers = append(transfers, transfer)
+			}
+		case TransferSingleEventTopic:
+			if len(l.Topics) != 4 || len(l.Data) != 64 {
+				continue
+			}
+			transfers = append(transfers, TokenTransfer{
+				Standard: TokenStandardERC1155,
+				Token:    l.Address,
+				From:     common.BytesToAddress(l.Topics[2].Bytes()),
+				To:       common.BytesToAddress(l.Topics[3].Bytes()),
+				TokenId:  new(big.Int).SetBytes(l.Data[:32]),
+				Value:    new(big.Int).SetBytes(l.Data[32:]),
+			})
+		case TransferBatchEventTopic:
+			if len(l.Topics) != 4 {
+				continue
+			}
+			values, err := uint256ArrayPair.Unpack(l.Data)
+			if err != nil {
+				continue
+			}
+			ids, amounts := values[0].([]*big.Int), values[1].([]*big.Int)
+			if len(ids) != len(amounts) {
+				continue
+			}
+			for i := range ids {
+				transfers = append(transfers, TokenTransfer{
+					Standard: TokenStandardERC1155,
+					Token:    l.Address,
+					From:     common.BytesToAddress(l.Topics[2].Bytes()),
+					To:       common.BytesToAddress(l.Topics[3].Bytes()),
+					TokenId:  ids[i],
+					Value:    amounts[i],
+				})
+			}
+		}
+	}
+	return transfers
+}
+
+// parseTransfer ERC20 与 ERC721 的 Transfer 事件签名相同，靠 indexed 参数个数区分：
+// ERC20 为 3 个 topic、金额在 data 中；ERC721 为 4 个 topic、tokenId 在 topic3 中
+func parseTransfer(l *types.Log) (TokenTransfer, bool) {
+	switch {
+	case len(l.Topics) == 3 && len(l.Data) == 32:
+		return TokenTransfer{
+			Standard: TokenStandardERC20,
+			Token:    l.Address,
+			From:     common.BytesToAddress(l.Topics[1].Bytes()),
+			To:       common.BytesToAddress(l.Topics[2].Bytes()),
+			Value:    new(big.Int).SetBytes(l.Data),
+		}, true
+	case len(l.Topics) == 4 && len(l.Data) == 0:
+		return TokenTransfer{
+			Standard: TokenStandardERC721,
+			Token:    l.Address,
+			From:     common.BytesToAddress(l.Topics[1].Bytes()),
+			To:       common.BytesToAddress(l.Topics[2].Bytes()),
+			TokenId:  l.Topics[3].Big(),
+			Value:    big.NewInt(1),
+		}, true
+	}
+	return TokenTransfer{}, false
+}
+
+// ParseTransferCall 识别调用数据中的 ERC20 transfer、ERC721 / ERC1155 safeTransferFrom，token 为被调用的合约地址；
+// ERC20 transfer 的 From 为交易发送方，调用数据中没有，由调用方补充
+func ParseTransferCall(token common.Address, data []byte) (TokenTransfer, bool) {
+	if len(data) < 4 {
+		return TokenTransfer{}, false
+	}
+	selector, args := data[:4], data[4:]
+	word := func(i int) []byte { return args[i*32 : (i+1)*32] }
+	switch {
+	case bytes.Equal(selector, Erc20TransferSelector) && len(args) >= 2*32:
+		return TokenTransfer{
+			Standard: TokenStandardERC20,
+			Token:    token,
+			To:       common.BytesToAddress(word(0)),
+			Value:    new(big.Int).SetBytes(word(1)),
+		}, true
+	case bytes.Equal(selector, Erc721SafeTransferFromSelector) && len(args) >= 3*32:
+		return TokenTransfer{
+			Standard: TokenStandardERC721,
+			Token:    token,
+			From:     common.BytesToAddress(word(0)),
+			To:       common.BytesToAddress(word(1)),
+			TokenId:  new(big.Int).SetBytes(word(2)),
+			Value:    big.NewInt(1),
+		}, true
+	case bytes.Equal(selector, Erc1155SafeTransferFromSelector) && len(args) >= 5*32:
+		return TokenTransfer{
+			Standard: TokenStandardERC1155,
+			Token:    token,
+			From:     common.BytesToAddress(word(0)),
+			To:       common.BytesToAddress(word(1)),
+			TokenId:  new(big.Int).SetBytes(word(2)),
+			Value:    new(big.Int).SetBytes(word(3)),
+		}, true
+	}
+	return TokenTransfer{}, false
+}
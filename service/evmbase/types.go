@@ -13,21 +13,28 @@ import (
 type EVMClient interface {
 	BlockHeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	BlockHeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
-	BlockHeadersByRange(ctx context.Context, startHeight, endHeight *big.Int, chainId uint) ([]types.Header, error)
+	BlockHeadersByRange(ctx context.Context, startHeight, endHeight *big.Int) ([]types.Header, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*RpcBlock, error)
 	BlockByHash(ctx context.Context, hash common.Hash) (*RpcBlock, error)
 	LatestSafeBlockHeader(ctx context.Context) (*types.Header, error)
 	LatestFinalizedBlockHeader(ctx context.Context) (*types.Header, error)
 	TxCountByAddress(ctx context.Context, address common.Address) (hexutil.Uint64, error)
+	PendingNonceAt(ctx context.Context, address common.Address) (uint64, error)
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
 	SendRawTransaction(ctx context.Context, rawTx string) (*common.Hash, error)
 	TxByHash(ctx context.Context, hash common.Hash) (*types.Transaction, error)
+	RawTxByHash(ctx context.Context, hash common.Hash) ([]byte, error)
 	TxReceiptByHash(ctx context.Context, hash common.Hash) (*types.Receipt, error)
 	GetStorageHash(ctx context.Context, address common.Address, blockNumber *big.Int) (common.Hash, error)
-	EthGetCode(ctx context.Context, address common.Address) (string, error)
+	EthGetCode(ctx context.Context, address common.Address) (AccountCode, error)
 	GetBalance(ctx context.Context, address common.Address) (*big.Int, error)
-	FilterLogs(ctx context.Context, filterQuery ethereum.FilterQuery, chainId uint) (Logs, error)
+	FilterLogs(ctx context.Context, filterQuery ethereum.FilterQuery) (Logs, error)
+	TraceTransaction(ctx context.Context, hash common.Hash) (*CallFrame, error)
+	TraceBlockByNumber(ctx context.Context, number *big.Int) ([]TxTraceResult, error)
 	Close(ctx context.Context)
 }
 
@@ -47,6 +54,8 @@ type TransactionList struct {
 	To    string `json:"to"`
 	Hash  string `json:"hash"`
 	Value string `json:"value"`
+	// GasPrice legacy 链按近期区块交易的 gasPrice 分位数估算费用
+	GasPrice string `json:"gasPrice"`
 }
 
 type RpcBlock struct {
@@ -54,6 +63,8 @@ type RpcBlock struct {
 	Number       string            `json:"number"`
 	Transactions []TransactionList `json:"transactions"`
 	BaseFee      string            `json:"baseFeePerGas"`
+	// ExcessBlobGas EIP-4844 之后的区块才有
+	ExcessBlobGas *hexutil.Uint64 `json:"excessBlobGas"`
 }
 
 func (b *RpcBlock) NumberUint64() (uint64, error) {
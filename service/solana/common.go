@@ -2,9 +2,9 @@ package solana
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
 	"github.com/web3-fighter/wallet-chain-account/service/svmbase"
 	"sort"
 	"strconv"
@@ -15,14 +15,14 @@ import (
 func buildTxMessage(txResult *svmbase.TransactionResult) (domain.TxMessage, error) {
 	res := domain.TxMessage{}
 	if txResult == nil {
-		return res, errors.New("empty transaction result")
+		return res, errcode.New(errcode.NotFound, "empty transaction result")
 	}
 
 	if len(txResult.Transaction.Signatures) == 0 {
-		return res, errors.New("invalid transaction: no signatures")
+		return res, errcode.New(errcode.InvalidArgument, "invalid transaction: no signatures")
 	}
 	if len(txResult.Transaction.Message.AccountKeys) == 0 {
-		return res, errors.New("invalid transaction: no account keys")
+		return res, errcode.New(errcode.InvalidArgument, "invalid transaction: no account keys")
 	}
 
 	res.Hash = txResult.Transaction.Signatures[0]
@@ -53,10 +53,10 @@ func buildTxMessage(txResult *svmbase.TransactionResult) (domain.TxMessage, erro
 
 func validateParam(param domain.GetTxByHashParam) error {
 	if param.Hash == "" {
-		return fmt.Errorf("invalid request: empty transaction hash")
+		return errcode.New(errcode.InvalidArgument, "invalid request: empty transaction hash")
 	}
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		return fmt.Errorf("invalid chain or network: %s", msg)
+		return errcode.New(errcode.InvalidArgument, "invalid chain or network: %s", msg)
 	}
 	return nil
 }
@@ -128,28 +128,36 @@ func organizeTransactionsByBlock(txResults []*svmbase.TransactionResult) ([]doma
 func validateBlockRangeParam(param domain.BlockHeaderByRangeParam) error {
 	startSlot, err := strconv.ParseUint(param.Start, 10, 64)
 	if err != nil {
-		return fmt.Errorf("invalid start height format: %s", err)
+		return errcode.New(errcode.InvalidArgument, "invalid start height format: %s", err)
 	}
 	endSlot, err := strconv.ParseUint(param.End, 10, 64)
 	if err != nil {
-		return fmt.Errorf("invalid end height format: %s", err)
+		return errcode.New(errcode.InvalidArgument, "invalid end height format: %s", err)
 	}
 
 	if startSlot > endSlot {
-		return fmt.Errorf("invalid height range: start height greater than end height")
+		return errcode.New(errcode.InvalidArgument, "invalid height range: start height greater than end height")
 	}
 
-	if startSlot-endSlot > MaxBlockRange {
-		return fmt.Errorf("invalid range: exceeds maximum allowed range of %d", MaxBlockRange)
+	if endSlot-startSlot > MaxBlockRange {
+		return errcode.New(errcode.InvalidArgument, "invalid range: exceeds maximum allowed range of %d", MaxBlockRange)
 	}
 
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		return fmt.Errorf("invalid chain or network: %s", msg)
+		return errcode.New(errcode.InvalidArgument, "invalid chain or network: %s", msg)
 	}
 
 	return nil
 }
 
+// wrapRpcError 按节点返回的错误打上分类：余额不足，其余视为上游节点不可用
+func wrapRpcError(err error, msg string) error {
+	if strings.Contains(strings.ToLower(err.Error()), "insufficient funds") {
+		return errcode.Wrap(errcode.InsufficientFunds, err, msg)
+	}
+	return errcode.Wrap(errcode.UpstreamUnavailable, err, msg)
+}
+
 func validatePublicKey(pubKey string) (bool, string) {
 	if pubKey == "" {
 		return false, "public key cannot be empty"
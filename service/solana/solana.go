@@ -6,7 +6,6 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/log"
@@ -19,6 +18,7 @@ import (
 	"github.com/mr-tron/base58"
 	"github.com/web3-fighter/chain-explorer-api/types"
 	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
 	"github.com/web3-fighter/wallet-chain-account/service"
 	"github.com/web3-fighter/wallet-chain-account/service/svmbase"
 	"github.com/web3-fighter/wallet-chain-account/service/unimplemente"
@@ -48,19 +48,19 @@ type SOLNodeService struct {
 
 func (s *SOLNodeService) ConvertAddress(_ context.Context, param domain.ConvertAddressParam) (string, error) {
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		err := fmt.Errorf("GetSupportChains validateChainAndNetwork fail, err msg = %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "GetSupportChains validateChainAndNetwork fail, err msg = %s", msg)
 		log.Error("err", err)
 		return "", err
 	}
 	pubKeyHex := param.PublicKey
 	if ok, msg := validatePublicKey(pubKeyHex); !ok {
-		err := fmt.Errorf("ConvertAddress validatePublicKey fail, err msg = %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "ConvertAddress validatePublicKey fail, err msg = %s", msg)
 		log.Error("err", err)
 		return "", err
 	}
 	accountAddress, err := svmbase.PubKeyHexToAddress(pubKeyHex)
 	if err != nil {
-		err = fmt.Errorf("ConvertAddress PubKeyHexToAddress failed: %w", err)
+		err = errcode.Wrap(errcode.InvalidArgument, err, "ConvertAddress PubKeyHexToAddress failed")
 		log.Error("err", err)
 		return "", err
 	}
@@ -68,30 +68,31 @@ func (s *SOLNodeService) ConvertAddress(_ context.Context, param domain.ConvertA
 
 }
 
-func (s *SOLNodeService) ValidAddress(_ context.Context, param domain.ValidAddressParam) (bool, error) {
+func (s *SOLNodeService) ValidAddress(_ context.Context, param domain.ValidAddressParam) (domain.AddressValidation, error) {
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		err := fmt.Errorf("ValidAddress validateChainAndNetwork failed: %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "ValidAddress validateChainAndNetwork failed: %s", msg)
 		log.Error("err", err)
-		return false, err
+		return domain.AddressValidation{}, err
 	}
 	address := param.Address
 	if len(address) == 0 {
-		err := fmt.Errorf("ValidAddress address is empty")
+		err := errcode.New(errcode.InvalidArgument, "ValidAddress address is empty")
 		log.Error("err", err)
-		return false, err
+		return domain.AddressValidation{}, err
 	}
 	if len(address) != 43 && len(address) != 44 {
-		err := fmt.Errorf("invalid Solana address length: expected 43 or 44 characters, got %d", len(address))
-		return false, err
+		err := errcode.New(errcode.InvalidArgument, "invalid Solana address length: expected 43 or 44 characters, got %d", len(address))
+		return domain.AddressValidation{}, err
 	}
-	return true, nil
+	// base58 地址没有大小写校验和
+	return domain.AddressValidation{Valid: true, Address: address}, nil
 }
 
 func (s *SOLNodeService) GetBlockByNumber(ctx context.Context, param domain.BlockNumberParam) (domain.Block, error) {
 	response := domain.Block{}
 
 	if ok, msg := validateChainAndNetwork(param.Chain, ""); !ok {
-		err := fmt.Errorf("GetBlockByNumber validateChainAndNetwork failed: %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "GetBlockByNumber validateChainAndNetwork failed: %s", msg)
 		log.Error("err", err)
 		return response, err
 	}
@@ -99,7 +100,7 @@ func (s *SOLNodeService) GetBlockByNumber(ctx context.Context, param domain.Bloc
 	if param.Height == 0 {
 		latestSlot, err := s.svmClient.GetSlot(ctx, svmbase.Finalized)
 		if err != nil {
-			err = fmt.Errorf("GetBlockByNumber GetSlot failed: %w", err)
+			err = wrapRpcError(err, "GetBlockByNumber GetSlot failed")
 			log.Error("err", err)
 			return response, err
 		}
@@ -110,7 +111,7 @@ func (s *SOLNodeService) GetBlockByNumber(ctx context.Context, param domain.Bloc
 	if param.ViewTx {
 		tempBlockBySlot, err := s.svmClient.GetBlockBySlot(ctx, resultSlot, svmbase.Signatures)
 		if err != nil {
-			err = fmt.Errorf("GetBlockByNumber GetBlockBySlot failed: %w", err)
+			err = wrapRpcError(err, "GetBlockByNumber GetBlockBySlot failed")
 			log.Error("err", err)
 			return response, err
 		}
@@ -118,7 +119,7 @@ func (s *SOLNodeService) GetBlockByNumber(ctx context.Context, param domain.Bloc
 	} else {
 		tempBlockBySlot, err := s.svmClient.GetBlockBySlot(ctx, resultSlot, svmbase.None)
 		if err != nil {
-			err = fmt.Errorf("GetBlockByNumber GetBlockBySlot failed: %w", err)
+			err = wrapRpcError(err, "GetBlockByNumber GetBlockBySlot failed")
 			log.Error("err", err)
 			return response, err
 		}
@@ -142,13 +143,13 @@ func (s *SOLNodeService) GetBlockByNumber(ctx context.Context, param domain.Bloc
 func (s *SOLNodeService) GetBlockByHash(ctx context.Context, param domain.BlockHashParam) (domain.Block, error) {
 	response := domain.Block{}
 	if ok, msg := validateChainAndNetwork(param.Chain, ""); !ok {
-		err := fmt.Errorf("GetBlockByHash validateChainAndNetwork fail, err msg = %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "GetBlockByHash validateChainAndNetwork fail, err msg = %s", msg)
 		return response, err
 	}
 
 	blockResult, err := s.svmClient.GetBlockByHash(ctx, param.Hash)
 	if err != nil {
-		return response, err
+		return response, wrapRpcError(err, "GetBlockByHash failed")
 	}
 	// 填充基本字段
 	response.Hash = blockResult.BlockHash
@@ -167,7 +168,7 @@ func (s *SOLNodeService) GetBlockByHash(ctx context.Context, param domain.BlockH
 func (s *SOLNodeService) GetBlockHeaderByNumber(ctx context.Context, param domain.BlockHeaderNumberParam) (domain.BlockHeader, error) {
 	response := domain.BlockHeader{}
 	if ok, msg := validateChainAndNetwork(param.Chain, ""); !ok {
-		err := fmt.Errorf("GetBlockHeaderByNumber validateChainAndNetwork failed: %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "GetBlockHeaderByNumber validateChainAndNetwork failed: %s", msg)
 		log.Error("err", err)
 		return response, err
 	}
@@ -176,7 +177,7 @@ func (s *SOLNodeService) GetBlockHeaderByNumber(ctx context.Context, param domai
 	if param.Height == 0 {
 		latestSlot, err := s.svmClient.GetSlot(ctx, svmbase.Finalized)
 		if err != nil {
-			err = fmt.Errorf("GetBlockHeaderByNumber GetSlot failed: %w", err)
+			err = wrapRpcError(err, "GetBlockHeaderByNumber GetSlot failed")
 			log.Error("err", err)
 			return response, err
 		}
@@ -185,7 +186,7 @@ func (s *SOLNodeService) GetBlockHeaderByNumber(ctx context.Context, param domai
 
 	blockResult, err := s.svmClient.GetBlockBySlot(ctx, resultSlot, svmbase.None)
 	if err != nil {
-		err = fmt.Errorf("GetBlockHeaderByNumber GetBlockBySlot failed: %w", err)
+		err = wrapRpcError(err, "GetBlockHeaderByNumber GetBlockBySlot failed")
 		log.Error("err", err)
 		return response, err
 	}
@@ -199,13 +200,13 @@ func (s *SOLNodeService) GetBlockHeaderByNumber(ctx context.Context, param domai
 func (s *SOLNodeService) GetBlockHeaderByHash(ctx context.Context, param domain.BlockHeaderHashParam) (domain.BlockHeader, error) {
 	response := domain.BlockHeader{}
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		err := fmt.Errorf("GetBlockByHash validateChainAndNetwork fail, err msg = %s", msg)
+		err := errcode.New(errcode.InvalidArgument, "GetBlockByHash validateChainAndNetwork fail, err msg = %s", msg)
 		return response, err
 	}
 
 	blockResult, err := s.svmClient.GetBlockByHash(ctx, param.Hash)
 	if err != nil {
-		return response, err
+		return response, wrapRpcError(err, "GetBlockByHash failed")
 	}
 	response.Hash = blockResult.BlockHash
 	response.Number = strconv.FormatUint(blockResult.BlockHeight, 10)
@@ -226,9 +227,9 @@ func (s *SOLNodeService) ListBlockHeaderByRange(ctx context.Context, param domai
 		blockResult, err := s.svmClient.GetBlockBySlot(ctx, slot, svmbase.Signatures)
 		if err != nil {
 			if len(resBlockHeaders) > 0 {
-				return resBlockHeaders, fmt.Errorf("partial success, stopped at slot %d: %v", slot, err)
+				return resBlockHeaders, wrapRpcError(err, fmt.Sprintf("partial success, stopped at slot %d", slot))
 			}
-			return resBlockHeaders, fmt.Errorf("failed to get signatures for slot %d: %v", slot, err)
+			return resBlockHeaders, wrapRpcError(err, fmt.Sprintf("failed to get signatures for slot %d", slot))
 		}
 		if len(blockResult.Signatures) == 0 {
 			continue
@@ -236,16 +237,16 @@ func (s *SOLNodeService) ListBlockHeaderByRange(ctx context.Context, param domai
 		txResults, err := s.svmClient.GetTransactionRange(ctx, blockResult.Signatures)
 		if err != nil {
 			if len(resBlockHeaders) > 0 {
-				return resBlockHeaders, fmt.Errorf("partial success, stopped at slot %d: %v", slot, err)
+				return resBlockHeaders, wrapRpcError(err, fmt.Sprintf("partial success, stopped at slot %d", slot))
 			}
-			return resBlockHeaders, fmt.Errorf("failed to get transactions for slot %d: %v", slot, err)
+			return resBlockHeaders, wrapRpcError(err, fmt.Sprintf("failed to get transactions for slot %d", slot))
 		}
 		blockHeaders, err := organizeTransactionsByBlock(txResults)
 		if err != nil {
 			if len(resBlockHeaders) > 0 {
-				return resBlockHeaders, fmt.Errorf("partial success, stopped at slot %d: %v", slot, err)
+				return resBlockHeaders, fmt.Errorf("partial success, stopped at slot %d: %w", slot, err)
 			}
-			return resBlockHeaders, fmt.Errorf("failed to organize transactions for slot %d: %v", slot, err)
+			return resBlockHeaders, fmt.Errorf("failed to organize transactions for slot %d: %w", slot, err)
 		}
 
 		if len(blockHeaders) > 0 {
@@ -254,7 +255,7 @@ func (s *SOLNodeService) ListBlockHeaderByRange(ctx context.Context, param domai
 	}
 
 	if len(resBlockHeaders) == 0 {
-		return nil, errors.New("no transactions found in range")
+		return nil, errcode.New(errcode.NotFound, "no transactions found in range")
 	}
 
 	return resBlockHeaders, nil
@@ -263,18 +264,18 @@ func (s *SOLNodeService) ListBlockHeaderByRange(ctx context.Context, param domai
 func (s *SOLNodeService) GetAccount(ctx context.Context, param domain.AccountParam) (domain.Account, error) {
 	response := domain.Account{}
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		return response, fmt.Errorf("GetAccount validateChainAndNetwork fail, err msg = %s", msg)
+		return response, errcode.New(errcode.InvalidArgument, "GetAccount validateChainAndNetwork fail, err msg = %s", msg)
 	}
 	accountInfoResp, err := s.svmClient.GetAccountInfo(ctx, param.Address)
 
 	if err != nil {
-		err = fmt.Errorf("GetAccount GetAccountInfo failed: %w", err)
+		err = wrapRpcError(err, "GetAccount GetAccountInfo failed")
 		log.Error("err", err)
-		return response, fmt.Errorf("GetAccount GetAccountInfo failed: %w", err)
+		return response, err
 	}
 	latestBlockHashResponse, err := s.svmClient.GetLatestBlockHash(ctx, svmbase.Finalized)
 	if err != nil {
-		err = fmt.Errorf("GetAccount GetLatestBlockhash failed: %w", err)
+		err = wrapRpcError(err, "GetAccount GetLatestBlockhash failed")
 		log.Error("err", err)
 		return response, err
 	}
@@ -288,17 +289,17 @@ func (s *SOLNodeService) GetAccount(ctx context.Context, param domain.AccountPar
 func (s *SOLNodeService) GetFee(ctx context.Context, param domain.FeeParam) (domain.Fee, error) {
 	response := domain.Fee{}
 	if ok, msg := validateChainAndNetwork(param.Chain, param.Network); !ok {
-		return response, fmt.Errorf("GetFee validateChainAndNetwork fail, err msg = %s", msg)
+		return response, errcode.New(errcode.InvalidArgument, "GetFee validateChainAndNetwork fail, err msg = %s", msg)
 	}
 	baseFee, err := s.svmClient.GetFeeForMessage(ctx, param.RawTx)
 	if err != nil {
-		err = fmt.Errorf("GetFee GetFeeForMessage failed: %w", err)
+		err = wrapRpcError(err, "GetFee GetFeeForMessage failed")
 		log.Error("err", err)
 		return response, err
 	}
 	priorityFees, err := s.svmClient.GetRecentPrioritizationFees(ctx)
 	if err != nil {
-		err = fmt.Errorf("GetFee GetRecentPrioritizationFees failed: %w", err)
+		err = wrapRpcError(err, "GetFee GetRecentPrioritizationFees failed")
 		log.Error("err", err)
 		return response, err
 	}
@@ -316,13 +317,13 @@ func (s *SOLNodeService) GetFee(ctx context.Context, param domain.FeeParam) (dom
 
 func (s *SOLNodeService) SendTx(ctx context.Context, param domain.SendTxParam) (string, error) {
 	if param.RawTx == "" {
-		return "", errors.New("invalid input: empty transaction")
+		return "", errcode.New(errcode.InvalidArgument, "invalid input: empty transaction")
 	}
 	// Send the transaction
 	txHash, err := s.svmClient.SendTransaction(ctx, param.RawTx, nil)
 	if err != nil {
 		log.Error("Failed to send transaction", "err", err)
-		return "", err
+		return "", wrapRpcError(err, "send transaction fail")
 	}
 
 	return txHash, nil
@@ -340,7 +341,7 @@ func (s *SOLNodeService) ListTxByAddress(ctx context.Context, param domain.TxAdd
 	}
 	if err != nil {
 		log.Error("get GetTxByAddress error", "err", err)
-		return nil, errors.New("get tx list fail")
+		return nil, errcode.Wrap(errcode.UpstreamUnavailable, err, "get tx list fail")
 	} else {
 		txs := resp.TransactionList
 		txMessages := make([]domain.TxMessage, 0, len(txs))
@@ -367,7 +368,7 @@ func (s *SOLNodeService) GetTxByHash(ctx context.Context, param domain.GetTxByHa
 	txResult, err := s.svmClient.GetTransaction(ctx, param.Hash)
 	if err != nil {
 		log.Error("GetTransaction failed", "error", err)
-		return domain.TxMessage{}, err
+		return domain.TxMessage{}, wrapRpcError(err, "GetTransaction failed")
 	}
 
 	txMessage, err := buildTxMessage(txResult)
@@ -380,12 +381,20 @@ func (s *SOLNodeService) GetTxByHash(ctx context.Context, param domain.GetTxByHa
 
 // CreateUnSignTransaction 根据用户提供的 Base64 编码交易参数，
 // 构造一个未签名的 Solana 原始交易（支持 SOL 和 SPL Token 转账）并返回其十六进制格式的签名消息体。
-func (s *SOLNodeService) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (string, error) {
+func (s *SOLNodeService) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (domain.UnSignTransaction, error) {
+	unSignTx, err := s.createUnSignTx(ctx, param)
+	if err != nil {
+		return domain.UnSignTransaction{}, err
+	}
+	return domain.UnSignTransaction{UnSignTx: unSignTx}, nil
+}
+
+func (s *SOLNodeService) createUnSignTx(ctx context.Context, param domain.UnSignTransactionParam) (string, error) {
 	// Decode the base64 transaction string
 	jsonBytes, err := base64.StdEncoding.DecodeString(param.Base64Tx)
 	if err != nil {
 		log.Error("Failed to decode base64 string", "err", err)
-		return "", err
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "decode base64 tx fail")
 	}
 
 	// Unmarshal JSON into TxStructure
@@ -393,7 +402,7 @@ func (s *SOLNodeService) CreateUnSignTransaction(ctx context.Context, param doma
 	// 解析 base64 编码并转换为结构体
 	if err = json.Unmarshal(jsonBytes, &data); err != nil {
 		log.Error("Failed to parse JSON", "err", err)
-		return "", err
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "parse tx json fail")
 	}
 
 	// 计算转账金额 先将 string 转 float，再根据精度转换为整数：
@@ -401,7 +410,7 @@ func (s *SOLNodeService) CreateUnSignTransaction(ctx context.Context, param doma
 	// Parse the value from string to float
 	valueFloat, err := strconv.ParseFloat(data.Value, 64)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse value: %w", err)
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "failed to parse value")
 	}
 	value := uint64(valueFloat * 1000000000)
 
@@ -409,14 +418,14 @@ func (s *SOLNodeService) CreateUnSignTransaction(ctx context.Context, param doma
 	// Convert from address to public key
 	fromPubKey, err := solana.PublicKeyFromBase58(data.FromAddress)
 	if err != nil {
-		return "", err
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "invalid from address")
 	}
 
 	// 地址转换为 PublicKey
 	// Convert to address to public key
 	toPubKey, err := solana.PublicKeyFromBase58(data.ToAddress)
 	if err != nil {
-		return "", err
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "invalid to address")
 	}
 
 	// 判断是 SOL 转账还是 SPL Token 转账
@@ -458,7 +467,7 @@ func (s *SOLNodeService) CreateUnSignTransaction(ctx context.Context, param doma
 		// 获取 token 的信息
 		tokenInfo, err := s.GetTokenSupply(ctx, mintPubKey)
 		if err != nil {
-			return "", fmt.Errorf("failed to get token info: %w", err)
+			return "", wrapRpcError(err, "failed to get token info")
 		}
 		// 获取 token 的 decimals 精度
 		decimals := tokenInfo.Value.Decimals
@@ -628,33 +637,33 @@ func (s *SOLNodeService) BuildSignedTransaction(ctx context.Context, param domai
 	jsonBytes, err := base64.StdEncoding.DecodeString(param.Base64Tx)
 	if err != nil {
 		log.Error("Failed to decode base64 string", "err", err)
-		return signedTransaction, err
+		return signedTransaction, errcode.Wrap(errcode.InvalidArgument, err, "decode base64 tx fail")
 	}
 
 	// Unmarshal JSON into TxStructure
 	var data TxStructure
 	if err = json.Unmarshal(jsonBytes, &data); err != nil {
 		log.Error("Failed to parse JSON", "err", err)
-		return signedTransaction, err
+		return signedTransaction, errcode.Wrap(errcode.InvalidArgument, err, "parse tx json fail")
 	}
 
 	// Parse the value from string to float
 	valueFloat, err := strconv.ParseFloat(data.Value, 64)
 	if err != nil {
-		return signedTransaction, fmt.Errorf("failed to parse value: %w", err)
+		return signedTransaction, errcode.Wrap(errcode.InvalidArgument, err, "failed to parse value")
 	}
 	value := uint64(valueFloat * 1000000000)
 
 	// Convert from address to public key
 	fromPubKey, err := solana.PublicKeyFromBase58(data.FromAddress)
 	if err != nil {
-		return signedTransaction, err
+		return signedTransaction, errcode.Wrap(errcode.InvalidArgument, err, "invalid from address")
 	}
 
 	// Convert to address to public key
 	toPubKey, err := solana.PublicKeyFromBase58(data.ToAddress)
 	if err != nil {
-		return signedTransaction, err
+		return signedTransaction, errcode.Wrap(errcode.InvalidArgument, err, "invalid to address")
 	}
 
 	var tx *solana.Transaction
@@ -694,7 +703,7 @@ func (s *SOLNodeService) BuildSignedTransaction(ctx context.Context, param domai
 		//tokenInfo, err := c.sdkClient.GetTokenSupply(context.Background(), mintPubKey, rpc.CommitmentFinalized)
 		tokenInfo, err := s.GetTokenSupply(ctx, mintPubKey)
 		if err != nil {
-			return signedTransaction, fmt.Errorf("Failed to get token info: %w", err)
+			return signedTransaction, wrapRpcError(err, "failed to get token info")
 		}
 		decimals := tokenInfo.Value.Decimals
 
@@ -743,11 +752,13 @@ func (s *SOLNodeService) BuildSignedTransaction(ctx context.Context, param domai
 	signatureBytes, err := hex.DecodeString(data.Signature)
 	if err != nil {
 		log.Error("Failed to decode hex signature", "err", err)
+		return signedTransaction, errcode.Wrap(errcode.InvalidArgument, err, "invalid signature")
 	}
 
 	// Verify the signature length
 	if len(signatureBytes) != 64 {
 		log.Error("Invalid signature length", "length", len(signatureBytes))
+		return signedTransaction, errcode.New(errcode.InvalidArgument, "invalid signature length: %d", len(signatureBytes))
 	}
 
 	// Convert to Solana Signature
@@ -757,11 +768,12 @@ func (s *SOLNodeService) BuildSignedTransaction(ctx context.Context, param domai
 	// Set the signature
 	tx.Signatures[0] = solSignature
 
-	// 验证签名是否正确（非强制）
+	// 验证签名是否与 from 地址匹配
 	// Dump the transaction for debugging
 	spew.Dump(tx)
 	if err = tx.VerifySignatures(); err != nil {
 		log.Info("Invalid signatures", "err", err)
+		return signedTransaction, errcode.Wrap(errcode.SignatureMismatch, err, "verify signature fail")
 	}
 
 	// Serialize the transaction
@@ -781,7 +793,7 @@ func (s *SOLNodeService) DecodeTransaction(ctx context.Context, param domain.Dec
 	// Decode base58 encoded transaction
 	rawTx, err := base58.Decode(param.RawTx)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode base58 transaction: %w", err)
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "failed to decode base58 transaction")
 	}
 
 	// Unmarshal binary transaction
@@ -789,7 +801,7 @@ func (s *SOLNodeService) DecodeTransaction(ctx context.Context, param domain.Dec
 	dec := bin.NewBinDecoder(rawTx)
 	err = tx.UnmarshalWithDecoder(dec)
 	if err != nil {
-		return "", fmt.Errorf("failed to unmarshal transaction: %w", err)
+		return "", errcode.Wrap(errcode.InvalidArgument, err, "failed to unmarshal transaction")
 	}
 	message := tx.Message
 
@@ -865,12 +877,12 @@ func (s *SOLNodeService) DecodeTransaction(ctx context.Context, param domain.Dec
 func (s *SOLNodeService) VerifySignedTransaction(ctx context.Context, param domain.VerifyTransactionParam) (bool, error) {
 	txBytes, err := base58.Decode(param.Signature)
 	if err != nil {
-		return false, fmt.Errorf("failed to decode transaction: %w", err)
+		return false, errcode.Wrap(errcode.InvalidArgument, err, "failed to decode transaction")
 	}
 
 	tx, err := solana.TransactionFromBytes(txBytes)
 	if err != nil {
-		return false, fmt.Errorf("failed to deserialize transaction: %w", err)
+		return false, errcode.Wrap(errcode.InvalidArgument, err, "failed to deserialize transaction")
 	}
 
 	if err = tx.VerifySignatures(); err != nil {
@@ -881,9 +893,31 @@ func (s *SOLNodeService) VerifySignedTransaction(ctx context.Context, param doma
 	return true, nil
 }
 
-func (s *SOLNodeService) GetExtraData(ctx context.Context, param domain.ExtraDataParam) (string, error) {
-	//TODO implement me
-	panic("implement me")
+// GetChainCapabilities 未列出的方法由 UnimplementedService 返回 Unimplemented
+func (s *SOLNodeService) GetChainCapabilities(_ context.Context, param domain.ChainCapabilitiesParam) ([]domain.ChainCapability, error) {
+	return []domain.ChainCapability{{
+		Chain:   ChainName,
+		Network: param.Network,
+		Methods: []string{
+			domain.MethodConvertAddress,
+			domain.MethodValidAddress,
+			domain.MethodGetBlockByNumber,
+			domain.MethodGetBlockByHash,
+			domain.MethodGetBlockHeaderByHash,
+			domain.MethodGetBlockHeaderByNumber,
+			domain.MethodListBlockHeaderByRange,
+			domain.MethodGetAccount,
+			domain.MethodGetFee,
+			domain.MethodSendTx,
+			domain.MethodListTxByAddress,
+			domain.MethodGetTxByHash,
+			domain.MethodCreateUnSignTransaction,
+			domain.MethodBuildSignedTransaction,
+			domain.MethodDecodeTransaction,
+			domain.MethodVerifySignedTransaction,
+		},
+		TxKinds: []string{domain.TxKindNative, domain.TxKindToken},
+	}}, nil
 }
 
 func NewSOLNodeService(svmClient svmbase.SVMClient, sdkClient *rpc.Client, solData *svmbase.SolData) service.WalletAccountService {
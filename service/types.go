@@ -7,20 +7,29 @@ import (
 
 type WalletAccountService interface {
 	GetSupportChains(ctx context.Context, param domain.SupportChainsParam) (bool, error)
+	GetChainCapabilities(ctx context.Context, param domain.ChainCapabilitiesParam) ([]domain.ChainCapability, error)
 	ConvertAddress(ctx context.Context, param domain.ConvertAddressParam) (string, error)
-	ValidAddress(ctx context.Context, param domain.ValidAddressParam) (bool, error)
+	ValidAddress(ctx context.Context, param domain.ValidAddressParam) (domain.AddressValidation, error)
 	GetBlockByNumber(ctx context.Context, param domain.BlockNumberParam) (domain.Block, error)
 	GetBlockByHash(ctx context.Context, param domain.BlockHashParam) (domain.Block, error)
 	GetBlockHeaderByHash(ctx context.Context, param domain.BlockHeaderHashParam) (domain.BlockHeader, error)
 	GetBlockHeaderByNumber(ctx context.Context, param domain.BlockHeaderNumberParam) (domain.BlockHeader, error)
 	ListBlockHeaderByRange(ctx context.Context, param domain.BlockHeaderByRangeParam) ([]domain.BlockHeader, error)
 	GetAccount(ctx context.Context, param domain.AccountParam) (domain.Account, error)
+	GetBalances(ctx context.Context, param domain.BalancesParam) (domain.Balances, error)
+	GetTokenInfo(ctx context.Context, param domain.TokenInfoParam) (domain.TokenInfo, error)
+	ResolveEnsName(ctx context.Context, param domain.EnsNameParam) (domain.EnsRecord, error)
+	LookupEnsName(ctx context.Context, param domain.EnsAddressParam) (domain.EnsRecord, error)
+	GetNonceStatus(ctx context.Context, param domain.NonceStatusParam) (domain.NonceStatus, error)
 	GetFee(ctx context.Context, param domain.FeeParam) (domain.Fee, error)
 	SendTx(ctx context.Context, param domain.SendTxParam) (string, error)
+	SimulateTransaction(ctx context.Context, param domain.SimulateTransactionParam) (domain.SimulationResult, error)
 	ListTxByAddress(ctx context.Context, param domain.TxAddressParam) ([]domain.TxMessage, error)
 	// ----------------
 	GetTxByHash(ctx context.Context, param domain.GetTxByHashParam) (domain.TxMessage, error)
-	CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (string, error)
+	CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (domain.UnSignTransaction, error)
+	CreateReplacementTransaction(ctx context.Context, param domain.ReplacementTransactionParam) (domain.ReplacementTransaction, error)
+	CreateUnSignAuthorization(ctx context.Context, param domain.UnSignAuthorizationParam) (string, error)
 	BuildSignedTransaction(ctx context.Context, param domain.SignedTransactionParam) (domain.SignedTransaction, error)
 	DecodeTransaction(ctx context.Context, param domain.DecodeTransactionParam) (string, error)
 	VerifySignedTransaction(ctx context.Context, param domain.VerifyTransactionParam) (bool, error)
@@ -3,81 +3,123 @@ package unimplemente
 import (
 	"context"
 	"github.com/web3-fighter/wallet-chain-account/domain"
+	"github.com/web3-fighter/wallet-chain-account/domain/errcode"
 	"github.com/web3-fighter/wallet-chain-account/service"
 )
 
 var _ service.WalletAccountService = (*UnimplementedService)(nil)
 
+// UnimplementedService 供各链实现嵌入，未覆盖的方法统一返回 errcode.Unimplemented 错误
 type UnimplementedService struct{}
 
+func notImplemented(method string) error {
+	return errcode.New(errcode.Unimplemented, "%s not implemented", method)
+}
+
+func (s *UnimplementedService) GetChainCapabilities(ctx context.Context, param domain.ChainCapabilitiesParam) ([]domain.ChainCapability, error) {
+	return nil, notImplemented("GetChainCapabilities")
+}
+
 func (s *UnimplementedService) GetBlockHeaderByNumber(ctx context.Context, param domain.BlockHeaderNumberParam) (domain.BlockHeader, error) {
-	return domain.BlockHeader{}, nil
+	return domain.BlockHeader{}, notImplemented("GetBlockHeaderByNumber")
 }
 
 func (s *UnimplementedService) GetSupportChains(ctx context.Context, param domain.SupportChainsParam) (bool, error) {
-	return true, nil
+	return false, notImplemented("GetSupportChains")
 }
 
 func (s *UnimplementedService) ConvertAddress(ctx context.Context, param domain.ConvertAddressParam) (string, error) {
-	return "", nil
+	return "", notImplemented("ConvertAddress")
 }
 
-func (s *UnimplementedService) ValidAddress(ctx context.Context, param domain.ValidAddressParam) (bool, error) {
-	return true, nil
+func (s *UnimplementedService) ValidAddress(ctx context.Context, param domain.ValidAddressParam) (domain.AddressValidation, error) {
+	return domain.AddressValidation{}, notImplemented("ValidAddress")
 }
 
 func (s *UnimplementedService) GetBlockByNumber(ctx context.Context, param domain.BlockNumberParam) (domain.Block, error) {
-	return domain.Block{}, nil
+	return domain.Block{}, notImplemented("GetBlockByNumber")
 }
 
 func (s *UnimplementedService) GetBlockByHash(ctx context.Context, param domain.BlockHashParam) (domain.Block, error) {
-	return domain.Block{}, nil
+	return domain.Block{}, notImplemented("GetBlockByHash")
 }
 
 func (s *UnimplementedService) GetBlockHeaderByHash(ctx context.Context, param domain.BlockHeaderHashParam) (domain.BlockHeader, error) {
-	return domain.BlockHeader{}, nil
+	return domain.BlockHeader{}, notImplemented("GetBlockHeaderByHash")
 }
 
 func (s *UnimplementedService) ListBlockHeaderByRange(ctx context.Context, param domain.BlockHeaderByRangeParam) ([]domain.BlockHeader, error) {
-	return nil, nil
+	return nil, notImplemented("ListBlockHeaderByRange")
 }
 
 func (s *UnimplementedService) GetAccount(ctx context.Context, param domain.AccountParam) (domain.Account, error) {
-	return domain.Account{}, nil
+	return domain.Account{}, notImplemented("GetAccount")
+}
+
+func (s *UnimplementedService) GetBalances(ctx context.Context, param domain.BalancesParam) (domain.Balances, error) {
+	return domain.Balances{}, notImplemented("GetBalances")
+}
+
+func (s *UnimplementedService) GetTokenInfo(ctx context.Context, param domain.TokenInfoParam) (domain.TokenInfo, error) {
+	return domain.TokenInfo{}, notImplemented("GetTokenInfo")
+}
+
+func (s *UnimplementedService) ResolveEnsName(ctx context.Context, param domain.EnsNameParam) (domain.EnsRecord, error) {
+	return domain.EnsRecord{}, notImplemented("ResolveEnsName")
+}
+
+func (s *UnimplementedService) LookupEnsName(ctx context.Context, param domain.EnsAddressParam) (domain.EnsRecord, error) {
+	return domain.EnsRecord{}, notImplemented("LookupEnsName")
+}
+
+func (s *UnimplementedService) GetNonceStatus(ctx context.Context, param domain.NonceStatusParam) (domain.NonceStatus, error) {
+	return domain.NonceStatus{}, notImplemented("GetNonceStatus")
 }
 
 func (s *UnimplementedService) GetFee(ctx context.Context, param domain.FeeParam) (domain.Fee, error) {
-	return domain.Fee{}, nil
+	return domain.Fee{}, notImplemented("GetFee")
 }
 
 func (s *UnimplementedService) SendTx(ctx context.Context, param domain.SendTxParam) (string, error) {
-	return "", nil
+	return "", notImplemented("SendTx")
+}
+
+func (s *UnimplementedService) SimulateTransaction(ctx context.Context, param domain.SimulateTransactionParam) (domain.SimulationResult, error) {
+	return domain.SimulationResult{}, notImplemented("SimulateTransaction")
 }
 
 func (s *UnimplementedService) ListTxByAddress(ctx context.Context, param domain.TxAddressParam) ([]domain.TxMessage, error) {
-	return nil, nil
+	return nil, notImplemented("ListTxByAddress")
 }
 
 func (s *UnimplementedService) GetTxByHash(ctx context.Context, param domain.GetTxByHashParam) (domain.TxMessage, error) {
-	return domain.TxMessage{}, nil
+	return domain.TxMessage{}, notImplemented("GetTxByHash")
+}
+
+func (s *UnimplementedService) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (domain.UnSignTransaction, error) {
+	return domain.UnSignTransaction{}, notImplemented("CreateUnSignTransaction")
 }
 
-func (s *UnimplementedService) CreateUnSignTransaction(ctx context.Context, param domain.UnSignTransactionParam) (string, error) {
-	return "", nil
+func (s *UnimplementedService) CreateReplacementTransaction(ctx context.Context, param domain.ReplacementTransactionParam) (domain.ReplacementTransaction, error) {
+	return domain.ReplacementTransaction{}, notImplemented("CreateReplacementTransaction")
 }
 
 func (s *UnimplementedService) BuildSignedTransaction(ctx context.Context, param domain.SignedTransactionParam) (domain.SignedTransaction, error) {
-	return domain.SignedTransaction{}, nil
+	return domain.SignedTransaction{}, notImplemented("BuildSignedTransaction")
+}
+
+func (s *UnimplementedService) CreateUnSignAuthorization(ctx context.Context, param domain.UnSignAuthorizationParam) (string, error) {
+	return "", notImplemented("CreateUnSignAuthorization")
 }
 
 func (s *UnimplementedService) DecodeTransaction(ctx context.Context, param domain.DecodeTransactionParam) (string, error) {
-	return "", nil
+	return "", notImplemented("DecodeTransaction")
 }
 
 func (s *UnimplementedService) VerifySignedTransaction(ctx context.Context, param domain.VerifyTransactionParam) (bool, error) {
-	return true, nil
+	return false, notImplemented("VerifySignedTransaction")
 }
 
 func (s *UnimplementedService) GetExtraData(ctx context.Context, param domain.ExtraDataParam) (string, error) {
-	return "", nil
+	return "", notImplemented("GetExtraData")
 }